@@ -3,40 +3,76 @@ package auth
 import (
 	"TUI_playlist_reorder/infrastructure/token_manager"
 	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
 	"net/http"
 	"net/url"
 	"os"
+	"strings"
+	"time"
 )
 
 type authenticationServiceImpl struct {
 	clienteSecretFilePath string
-	defaultRedirectURL    string
+	deviceSecretFilePath  string
+	scopes                []string
 	oauthConfig           *oauth2.Config
 	tokenService          token_manager.TokenService
 }
 
+// LoginAttempt guarda os segredos de uma tentativa de login: o state contra CSRF, o
+// code verifier do PKCE e o redirect URI usado, que precisam ser enviados de volta em
+// ExchangeCodeForToken.
+type LoginAttempt struct {
+	State       string
+	Verifier    string
+	RedirectURL string
+	AuthURL     string
+}
+
+// TokenDetails descreve o token salvo do perfil para a tela de conta.
+type TokenDetails struct {
+	Expiry          time.Time
+	Scopes          []string
+	HasRefreshToken bool
+}
+
+const (
+	revokeURL    = "https://oauth2.googleapis.com/revoke"
+	tokenInfoURL = "https://oauth2.googleapis.com/tokeninfo"
+)
+
 type AuthenticationService interface {
 	GetAuthenticatedClient(ctx context.Context) (*http.Client, *oauth2.Token, error)
-	GenerateAuthURL(state string) string
+	NewLoginAttempt(redirectURL string) (LoginAttempt, error)
 	RevokeToken(tokenToRevoke string) error
-	ExchangeCodeForToken(ctx context.Context, code string) (*oauth2.Token, error)
+	ExchangeCodeForToken(ctx context.Context, code string, attempt LoginAttempt) (*oauth2.Token, error)
+	TokenDetails(ctx context.Context) (TokenDetails, error)
+	SignOut(ctx context.Context) error
+	StartDeviceLogin(ctx context.Context) (*oauth2.DeviceAuthResponse, error)
+	WaitForDeviceToken(ctx context.Context, device *oauth2.DeviceAuthResponse) (*oauth2.Token, error)
 	//RevokeToken(ctx context.Context, token *oauth2.Token) error
 }
 
-func NewAuthenticationService(scopes []string, clienteSecretFilePath, redirectURL string, tokenServer token_manager.TokenService) (AuthenticationService, error) {
+// NewAuthenticationService carrega o cliente OAuth2. O redirect URI não é fixo: cada
+// tentativa de login informa o seu em NewLoginAttempt. deviceSecretFilePath é lido só no
+// login por código de dispositivo (vazio usa o mesmo cliente).
+func NewAuthenticationService(scopes []string, clienteSecretFilePath, deviceSecretFilePath string, tokenServer token_manager.TokenService) (AuthenticationService, error) {
 	config, err := loadConfig(scopes, clienteSecretFilePath)
 	if err != nil {
 		return nil, fmt.Errorf("não foi possível carregar a configuração do cliente: %w", err)
 	}
 
-	config.RedirectURL = redirectURL
-
 	return &authenticationServiceImpl{
 		clienteSecretFilePath: clienteSecretFilePath,
-		defaultRedirectURL:    redirectURL,
+		deviceSecretFilePath:  deviceSecretFilePath,
+		scopes:                scopes,
 		tokenService:          tokenServer,
 		oauthConfig:           config,
 	}, nil
@@ -53,6 +89,9 @@ func loadConfig(scopes []string, clienteSecretFilePath string) (*oauth2.Config,
 		return nil, fmt.Errorf("não foi possível analisar a configuração do cliente a partir do arquivo JSON: %w", err)
 	}
 
+	// o JSON do cliente não traz o endpoint do fluxo de dispositivo
+	config.Endpoint.DeviceAuthURL = google.Endpoint.DeviceAuthURL
+
 	return config, nil
 }
 
@@ -62,14 +101,24 @@ func (a *authenticationServiceImpl) GetAuthenticatedClient(ctx context.Context)
 		return nil, nil, fmt.Errorf("não foi possível carregar o token: %w", err)
 	}
 
-	tokenSource := a.oauthConfig.TokenSource(ctx, token)
+	config, err := a.issuerConfig(token)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tokenSource := config.TokenSource(ctx, token)
 	refreshedToken, err := tokenSource.Token()
 	if err != nil {
-		_ = a.tokenService.DeleteLocalToken()
+		// só um refresh token recusado pelo Google invalida o login; falhas de rede não
+		var retrieveErr *oauth2.RetrieveError
+		if errors.As(err, &retrieveErr) && retrieveErr.ErrorCode == "invalid_grant" {
+			_ = a.tokenService.DeleteLocalToken()
+		}
 		return nil, nil, fmt.Errorf("não foi possível atualizar o token: %w", err)
 	}
 
 	if refreshedToken.AccessToken != token.AccessToken || (refreshedToken.RefreshToken != "" && refreshedToken.RefreshToken != token.RefreshToken) {
+		refreshedToken = withClient(refreshedToken, config.ClientID)
 		if errSave := a.tokenService.SaveToken(refreshedToken); errSave != nil {
 			return nil, nil, fmt.Errorf("não foi possível salvar o token atualizado: %w", errSave)
 		}
@@ -78,15 +127,87 @@ func (a *authenticationServiceImpl) GetAuthenticatedClient(ctx context.Context)
 	return oauth2.NewClient(ctx, tokenSource), refreshedToken, nil
 }
 
-func (a *authenticationServiceImpl) GenerateAuthURL(state string) string {
-	return a.oauthConfig.AuthCodeURL(state, oauth2.AccessTypeOffline)
+// issuerConfig é a configuração do cliente OAuth que emitiu o token, o único que consegue
+// renová-lo: o do login por dispositivo pode ser outro (device_client_secret_file). Tokens
+// gravados antes de o cliente ser registrado usam o cliente principal.
+func (a *authenticationServiceImpl) issuerConfig(token *oauth2.Token) (*oauth2.Config, error) {
+	clientID, _ := token.Extra(token_manager.TokenClientIDKey).(string)
+	if clientID == "" || clientID == a.oauthConfig.ClientID {
+		return a.oauthConfig, nil
+	}
+
+	config, err := a.deviceConfig()
+	if err != nil {
+		return nil, err
+	}
+	if config.ClientID != clientID {
+		return nil, fmt.Errorf("o token foi emitido por um cliente OAuth que não está mais configurado (%s); faça login de novo", clientID)
+	}
+	return config, nil
+}
+
+// withClient registra no token o cliente que o emitiu, gravado junto com ele.
+func withClient(token *oauth2.Token, clientID string) *oauth2.Token {
+	return token.WithExtra(map[string]interface{}{token_manager.TokenClientIDKey: clientID})
 }
 
-func (a *authenticationServiceImpl) ExchangeCodeForToken(ctx context.Context, code string) (*oauth2.Token, error) {
-	token, err := a.oauthConfig.Exchange(ctx, code)
+// NewLoginAttempt gera um state aleatório e um par verifier/challenge PKCE (S256),
+// como o Google recomenda para aplicativos instalados. redirectURL é o endereço real do
+// servidor de callback desta tentativa (com a porta efêmera escolhida pelo sistema).
+func (a *authenticationServiceImpl) NewLoginAttempt(redirectURL string) (LoginAttempt, error) {
+	if redirectURL == "" {
+		return LoginAttempt{}, fmt.Errorf("redirect URI do login ausente")
+	}
+
+	state, err := randomState()
+	if err != nil {
+		return LoginAttempt{}, fmt.Errorf("não foi possível gerar o state do login: %w", err)
+	}
+
+	verifier := oauth2.GenerateVerifier()
+
+	// select_account permite escolher outra conta Google ou canal de marca para cada perfil
+	authURL := a.configFor(redirectURL).AuthCodeURL(
+		state,
+		oauth2.AccessTypeOffline,
+		oauth2.SetAuthURLParam("prompt", "select_account consent"),
+		oauth2.S256ChallengeOption(verifier),
+	)
+
+	return LoginAttempt{
+		State:       state,
+		Verifier:    verifier,
+		RedirectURL: redirectURL,
+		AuthURL:     authURL,
+	}, nil
+}
+
+// configFor copia a configuração OAuth trocando o redirect URI, sem alterar a compartilhada.
+func (a *authenticationServiceImpl) configFor(redirectURL string) *oauth2.Config {
+	config := *a.oauthConfig
+	config.RedirectURL = redirectURL
+	return &config
+}
+
+func randomState() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func (a *authenticationServiceImpl) ExchangeCodeForToken(ctx context.Context, code string, attempt LoginAttempt) (*oauth2.Token, error) {
+	if attempt.Verifier == "" {
+		return nil, fmt.Errorf("code verifier do PKCE ausente")
+	}
+
+	// o Google exige o mesmo redirect_uri usado na URL de autorização
+	token, err := a.configFor(attempt.RedirectURL).Exchange(ctx, code, oauth2.VerifierOption(attempt.Verifier))
 	if err != nil {
 		return nil, fmt.Errorf("não foi possível trocar o código de autorização por um token: %w", err)
 	}
+	token = withClient(token, a.oauthConfig.ClientID)
 
 	if err = a.tokenService.SaveToken(token); err != nil {
 		return nil, fmt.Errorf("não foi possível salvar o token: %w", err)
@@ -95,13 +216,160 @@ func (a *authenticationServiceImpl) ExchangeCodeForToken(ctx context.Context, co
 	return token, nil
 }
 
+// ParseManualCode extrai o código de autorização do que o usuário colou: a URL completa
+// para a qual o navegador foi redirecionado (o state é conferido) ou apenas o código.
+func ParseManualCode(input string, attempt LoginAttempt) (string, error) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return "", fmt.Errorf("cole a URL de retorno ou o código de autorização")
+	}
+
+	if !strings.Contains(input, "://") && !strings.Contains(input, "?") {
+		return input, nil
+	}
+
+	parsed, err := url.Parse(input)
+	if err != nil {
+		return "", fmt.Errorf("URL de retorno inválida: %w", err)
+	}
+
+	query := parsed.Query()
+	if authErr := query.Get("error"); authErr != "" {
+		return "", fmt.Errorf("erro de autorização do provedor OAuth: %s", authErr)
+	}
+
+	if subtle.ConstantTimeCompare([]byte(query.Get("state")), []byte(attempt.State)) != 1 {
+		return "", fmt.Errorf("state CSRF inválido: a URL não pertence a esta tentativa de login")
+	}
+
+	code := query.Get("code")
+	if code == "" {
+		return "", fmt.Errorf("código de autorização não encontrado na URL")
+	}
+
+	return code, nil
+}
+
+// StartDeviceLogin inicia o fluxo de autorização de dispositivo (RFC 8628): o usuário
+// digita o código retornado na URL de verificação, em qualquer aparelho com navegador.
+func (a *authenticationServiceImpl) StartDeviceLogin(ctx context.Context) (*oauth2.DeviceAuthResponse, error) {
+	config, err := a.deviceConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	device, err := config.DeviceAuth(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("não foi possível iniciar o login por código de dispositivo (o cliente OAuth precisa ser do tipo \"TV e dispositivos de entrada limitada\"): %w", err)
+	}
+
+	return device, nil
+}
+
+// WaitForDeviceToken consulta o Google no intervalo pedido até o usuário autorizar,
+// negar ou o código expirar, e salva o token obtido.
+func (a *authenticationServiceImpl) WaitForDeviceToken(ctx context.Context, device *oauth2.DeviceAuthResponse) (*oauth2.Token, error) {
+	config, err := a.deviceConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := config.DeviceAccessToken(ctx, device)
+	if err != nil {
+		return nil, fmt.Errorf("não foi possível obter o token do dispositivo: %w", err)
+	}
+	token = withClient(token, config.ClientID)
+
+	if err = a.tokenService.SaveToken(token); err != nil {
+		return nil, fmt.Errorf("não foi possível salvar o token: %w", err)
+	}
+
+	return token, nil
+}
+
+func (a *authenticationServiceImpl) deviceConfig() (*oauth2.Config, error) {
+	if a.deviceSecretFilePath == "" || a.deviceSecretFilePath == a.clienteSecretFilePath {
+		return a.oauthConfig, nil
+	}
+
+	config, err := loadConfig(a.scopes, a.deviceSecretFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("não foi possível carregar o cliente do login por dispositivo: %w", err)
+	}
+
+	return config, nil
+}
+
+// TokenDetails renova o token se preciso e consulta o endpoint tokeninfo do Google, já que
+// os escopos concedidos não ficam gravados no arquivo do token.
+func (a *authenticationServiceImpl) TokenDetails(ctx context.Context) (TokenDetails, error) {
+	_, token, err := a.GetAuthenticatedClient(ctx)
+	if err != nil {
+		return TokenDetails{}, err
+	}
+
+	details := TokenDetails{
+		Expiry:          token.Expiry,
+		HasRefreshToken: token.RefreshToken != "",
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tokenInfoURL+"?access_token="+url.QueryEscape(token.AccessToken), nil)
+	if err != nil {
+		return details, fmt.Errorf("falha ao montar a consulta do token: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return details, fmt.Errorf("falha ao consultar os escopos do token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return details, fmt.Errorf("falha ao consultar os escopos do token, status: %s", resp.Status)
+	}
+
+	var info struct {
+		Scope string `json:"scope"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return details, fmt.Errorf("falha ao decodificar a resposta do tokeninfo: %w", err)
+	}
+	details.Scopes = strings.Fields(info.Scope)
+
+	return details, nil
+}
+
+// SignOut revoga o token no Google e apaga o arquivo local. O token local é apagado mesmo
+// se a revogação falhar (ex.: sem rede); nesse caso o erro é retornado para ser exibido.
+func (a *authenticationServiceImpl) SignOut(ctx context.Context) error {
+	token, err := a.tokenService.LoadToken()
+	if err != nil {
+		return fmt.Errorf("nenhum token para encerrar a sessão: %w", err)
+	}
+
+	// revogar o refresh token invalida também os access tokens emitidos a partir dele
+	toRevoke := token.RefreshToken
+	if toRevoke == "" {
+		toRevoke = token.AccessToken
+	}
+	revokeErr := a.RevokeToken(toRevoke)
+
+	if err := a.tokenService.DeleteLocalToken(); err != nil {
+		return err
+	}
+
+	if revokeErr != nil {
+		return fmt.Errorf("token removido localmente, mas a revogação no Google falhou: %w", revokeErr)
+	}
+
+	return nil
+}
+
 func (a *authenticationServiceImpl) RevokeToken(tokenToRevoke string) error {
 	if tokenToRevoke == "" {
 		return nil
 	}
 
-	revokeURL := "https://oauth2.googleapis.com/revoke"
-
 	data := url.Values{}
 	data.Set("token", tokenToRevoke)
 
@@ -0,0 +1,352 @@
+package config
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"TUI_playlist_reorder/infrastructure/logger"
+
+	"github.com/BurntSushi/toml"
+)
+
+const (
+	envPrefix      = "REORDER_PLAYLIST_"
+	configFileName = "config.toml"
+)
+
+// Config reúne tudo o que antes era constante em main.go. Os valores são aplicados
+// em camadas: padrão → arquivo de configuração → variáveis de ambiente → flags.
+type Config struct {
+	ClientSecretFile   string `toml:"client_secret_file" env:"CLIENT_SECRET_FILE" flag:"client-secret" usage:"arquivo JSON com as credenciais OAuth2"`
+	TokenFile          string `toml:"token_file" env:"TOKEN_FILE" flag:"token-file" usage:"arquivo onde o token OAuth2 do perfil padrão é salvo"`
+	Profile            string `toml:"profile" env:"PROFILE" flag:"profile" usage:"perfil (conta Google ou canal de marca) a usar"`
+	TokenBackend       string `toml:"token_backend" env:"TOKEN_BACKEND" flag:"token-backend" usage:"armazenamento do token: plain ou encrypted"`
+	DeviceSecretFile   string `toml:"device_client_secret_file" env:"DEVICE_CLIENT_SECRET_FILE" flag:"device-client-secret" usage:"credenciais OAuth2 do tipo \"TV e dispositivos de entrada limitada\" para o login por código (vazio usa client_secret_file)"`
+	LoginMethod        string `toml:"login_method" env:"LOGIN_METHOD" flag:"login-method" usage:"login: auto, browser, manual ou device"`
+	CallbackAddress    string `toml:"callback_address" env:"CALLBACK_ADDRESS" flag:"callback-address" usage:"endereço do servidor local de callback do OAuth2 (porta 0 = porta livre a cada login)"`
+	LogDir             string `toml:"log_dir" env:"LOG_DIR" flag:"log-dir" usage:"diretório dos arquivos de log"`
+	LogLevel           string `toml:"log_level" env:"LOG_LEVEL" flag:"log-level" usage:"nível mínimo de log (trace, debug, info, warning, error), com exceções por componente: info,provider=debug,tui=warning"`
+	LogFormat          string `toml:"log_format" env:"LOG_FORMAT" flag:"log-format" usage:"formato dos arquivos de log: json ou text"`
+	LogMaxSizeMB       int    `toml:"log_max_size_mb" env:"LOG_MAX_SIZE_MB" flag:"log-max-size-mb" usage:"tamanho máximo de cada arquivo de log em MB antes de rotacionar (0 = sem limite)"`
+	LogRotateHours     int    `toml:"log_rotate_hours" env:"LOG_ROTATE_HOURS" flag:"log-rotate-hours" usage:"horas até rotacionar o arquivo de log atual (0 = só por tamanho)"`
+	LogMaxAgeDays      int    `toml:"log_max_age_days" env:"LOG_MAX_AGE_DAYS" flag:"log-max-age-days" usage:"apaga arquivos de log mais antigos que N dias (0 = sem limite)"`
+	LogMaxFiles        int    `toml:"log_max_files" env:"LOG_MAX_FILES" flag:"log-max-files" usage:"número máximo de arquivos de log mantidos (0 = sem limite)"`
+	LogCompress        bool   `toml:"log_compress" env:"LOG_COMPRESS" flag:"log-compress" usage:"comprime com gzip os arquivos de log rotacionados (true/false)"`
+	DefaultSort        string `toml:"default_sort" env:"DEFAULT_SORT" flag:"default-sort" usage:"critério selecionado por padrão no menu de reordenação"`
+	Privacy            string `toml:"privacy" env:"PRIVACY" flag:"privacy" usage:"privacidade das playlists criadas (public, unlisted, private)"`
+	TitleTemplate      string `toml:"title_template" env:"TITLE_TEMPLATE" flag:"title-template" usage:"template do título sugerido para playlists salvas"`
+	QuotaBudget        int    `toml:"quota_budget" env:"QUOTA_BUDGET" flag:"quota-budget" usage:"máximo de unidades de quota da API por execução (0 = sem limite)"`
+	APITimeoutSeconds  int    `toml:"api_timeout_seconds" env:"API_TIMEOUT_SECONDS" flag:"api-timeout-seconds" usage:"prazo em segundos de cada chamada à API do YouTube (0 = sem prazo)"`
+	JobTimeoutMinutes  int    `toml:"job_timeout_minutes" env:"JOB_TIMEOUT_MINUTES" flag:"job-timeout-minutes" usage:"prazo em minutos de cada busca ou gravação inteira (0 = sem prazo)"`
+	JobJournalFile     string `toml:"job_journal_file" env:"JOB_JOURNAL_FILE" flag:"job-journal-file" usage:"arquivo do diário de operações remotas (início, fim, cancelamento)"`
+	SnapshotsDir       string `toml:"snapshots_dir" env:"SNAPSHOTS_DIR" flag:"snapshots-dir" usage:"diretório dos snapshots locais"`
+	SmartPlaylistsFile string `toml:"smart_playlists_file" env:"SMART_PLAYLISTS_FILE" flag:"smart-playlists-file" usage:"arquivo com as definições de smart playlists"`
+	SorterPluginsDir   string `toml:"sorter_plugins_dir" env:"SORTER_PLUGINS_DIR" flag:"sorter-plugins-dir" usage:"diretório dos plugins de ordenação"`
+	ScriptsDir         string `toml:"scripts_dir" env:"SCRIPTS_DIR" flag:"scripts-dir" usage:"diretório da biblioteca de scripts"`
+	CacheDir           string `toml:"cache_dir" env:"CACHE_DIR" flag:"cache-dir" usage:"diretório do cache de metadados dos vídeos (vazio desativa)"`
+
+	// Path é o arquivo de configuração considerado (--config, variável ou padrão), mesmo que não exista
+	Path string `toml:"-"`
+}
+
+// Default segue a XDG Base Directory Specification (ver xdg.go).
+func Default() Config {
+	return Config{
+		ClientSecretFile:   filepath.Join(ConfigDir(), "client_secret.json"),
+		TokenFile:          filepath.Join(StateDir(), "token.json"),
+		Profile:            "default",
+		TokenBackend:       "plain",
+		LoginMethod:        "auto",
+		CallbackAddress:    "127.0.0.1:0",
+		LogDir:             filepath.Join(StateDir(), "logs"),
+		LogLevel:           "info",
+		LogFormat:          logger.FormatJSON,
+		LogMaxSizeMB:       10,
+		LogRotateHours:     24,
+		LogMaxAgeDays:      30,
+		LogMaxFiles:        20,
+		LogCompress:        false,
+		DefaultSort:        "name",
+		Privacy:            "public",
+		TitleTemplate:      "{{.Title}}",
+		QuotaBudget:        0,
+		APITimeoutSeconds:  30,
+		JobTimeoutMinutes:  0,
+		JobJournalFile:     filepath.Join(StateDir(), "jobs.jsonl"),
+		SnapshotsDir:       filepath.Join(DataDir(), "snapshots"),
+		SmartPlaylistsFile: filepath.Join(ConfigDir(), "smart_playlists.json"),
+		SorterPluginsDir:   filepath.Join(DataDir(), "plugins", "sorters"),
+		ScriptsDir:         filepath.Join(DataDir(), "scripts"),
+		CacheDir:           CacheDir(),
+	}
+}
+
+// DefaultPath é o arquivo de configuração usado quando nem --config nem
+// REORDER_PLAYLIST_CONFIG são informados.
+func DefaultPath() string {
+	return filepath.Join(ConfigDir(), configFileName)
+}
+
+// Load monta a configuração a partir de args (sem o nome do binário) e retorna os
+// argumentos restantes, que correspondem ao subcomando da CLI.
+func Load(args []string) (Config, []string, error) {
+	cfg := Default()
+
+	fs := flag.NewFlagSet("reorder-playlist", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	configPath := fs.String("config", "", "arquivo de configuração")
+	flagValues := registerFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		// -h/--help não é erro: quem chama confere flag.ErrHelp e mostra a ajuda
+		return Config{}, nil, fmt.Errorf("flags inválidas: %w", err)
+	}
+
+	path := *configPath
+	if path == "" {
+		path = os.Getenv(envPrefix + "CONFIG")
+	}
+	explicit := path != ""
+	if !explicit {
+		path = DefaultPath()
+		// versões anteriores liam ./config.toml; ele continua valendo enquanto não existir
+		// um arquivo no diretório do XDG (os caminhos relativos dele são relativos a ".")
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			if _, err := os.Stat(configFileName); err == nil {
+				path = configFileName
+			}
+		}
+	}
+
+	if err := cfg.loadFile(path, explicit); err != nil {
+		return Config{}, nil, err
+	}
+	cfg.Path = path
+
+	if err := cfg.applyEnv(); err != nil {
+		return Config{}, nil, err
+	}
+
+	// só sobrescreve com flags que foram realmente passadas
+	var flagErr error
+	fs.Visit(func(f *flag.Flag) {
+		if value, ok := flagValues[f.Name]; ok && flagErr == nil {
+			flagErr = cfg.set(value.field, *value.raw)
+		}
+	})
+	if flagErr != nil {
+		return Config{}, nil, flagErr
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return Config{}, nil, err
+	}
+
+	return cfg, fs.Args(), nil
+}
+
+func (c *Config) loadFile(path string, required bool) error {
+	if _, err := os.Stat(path); err != nil {
+		if os.IsNotExist(err) && !required {
+			return nil
+		}
+		return fmt.Errorf("não foi possível ler o arquivo de configuração %s: %w", path, err)
+	}
+
+	fileCfg := *c
+	meta, err := toml.DecodeFile(path, &fileCfg)
+	if err != nil {
+		return fmt.Errorf("falha ao analisar o arquivo de configuração %s: %w", path, err)
+	}
+	if undecoded := meta.Undecoded(); len(undecoded) > 0 {
+		return fmt.Errorf("chave desconhecida '%s' no arquivo de configuração %s", undecoded[0], path)
+	}
+
+	// caminhos relativos no arquivo são relativos ao diretório do próprio arquivo,
+	// assim o binário funciona independentemente do diretório de onde é executado
+	baseDir := filepath.Dir(path)
+	for key, p := range fileCfg.pathFields() {
+		if meta.IsDefined(key) && *p != "" && !filepath.IsAbs(*p) {
+			*p = filepath.Join(baseDir, *p)
+		}
+	}
+
+	*c = fileCfg
+	return nil
+}
+
+// pathFields associa as chaves do arquivo aos campos que guardam caminhos.
+func (c *Config) pathFields() map[string]*string {
+	return map[string]*string{
+		"client_secret_file":        &c.ClientSecretFile,
+		"token_file":                &c.TokenFile,
+		"device_client_secret_file": &c.DeviceSecretFile,
+		"log_dir":                   &c.LogDir,
+		"snapshots_dir":             &c.SnapshotsDir,
+		"smart_playlists_file":      &c.SmartPlaylistsFile,
+		"sorter_plugins_dir":        &c.SorterPluginsDir,
+		"scripts_dir":               &c.ScriptsDir,
+		"cache_dir":                 &c.CacheDir,
+		"job_journal_file":          &c.JobJournalFile,
+	}
+}
+
+func (c *Config) applyEnv() error {
+	t := reflect.TypeOf(*c)
+	for i := 0; i < t.NumField(); i++ {
+		env := t.Field(i).Tag.Get("env")
+		if env == "" {
+			continue
+		}
+		if value, ok := os.LookupEnv(envPrefix + env); ok {
+			if err := c.set(t.Field(i).Name, value); err != nil {
+				return fmt.Errorf("variável %s%s: %w", envPrefix, env, err)
+			}
+		}
+	}
+	return nil
+}
+
+type flagValue struct {
+	field string
+	raw   *string
+}
+
+func registerFlags(fs *flag.FlagSet) map[string]flagValue {
+	values := make(map[string]flagValue)
+	t := reflect.TypeOf(Config{})
+	for i := 0; i < t.NumField(); i++ {
+		name := t.Field(i).Tag.Get("flag")
+		if name == "" {
+			continue
+		}
+		values[name] = flagValue{field: t.Field(i).Name, raw: fs.String(name, "", t.Field(i).Tag.Get("usage"))}
+	}
+	return values
+}
+
+func (c *Config) set(field, value string) error {
+	v := reflect.ValueOf(c).Elem().FieldByName(field)
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(value)
+	case reflect.Int:
+		n, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil {
+			return fmt.Errorf("valor inteiro inválido '%s' para %s", value, field)
+		}
+		v.SetInt(int64(n))
+	case reflect.Bool:
+		b, err := strconv.ParseBool(strings.TrimSpace(value))
+		if err != nil {
+			return fmt.Errorf("valor booleano inválido '%s' para %s (use true ou false)", value, field)
+		}
+		v.SetBool(b)
+	default:
+		return fmt.Errorf("campo de configuração %s não suportado", field)
+	}
+	return nil
+}
+
+func (c Config) Validate() error {
+	switch c.Privacy {
+	case "public", "unlisted", "private":
+	default:
+		return fmt.Errorf("privacy inválida '%s' (use public, unlisted ou private)", c.Privacy)
+	}
+
+	switch c.TokenBackend {
+	case "plain", "encrypted":
+	default:
+		return fmt.Errorf("token_backend inválido '%s' (use plain ou encrypted)", c.TokenBackend)
+	}
+
+	switch c.LoginMethod {
+	case "auto", "browser", "manual", "device":
+	default:
+		return fmt.Errorf("login_method inválido '%s' (use auto, browser, manual ou device)", c.LoginMethod)
+	}
+
+	if _, err := logger.ParseLevelSpec(c.LogLevel); err != nil {
+		return fmt.Errorf("log_level inválido '%s': %w", c.LogLevel, err)
+	}
+
+	switch c.LogFormat {
+	case logger.FormatJSON, logger.FormatText:
+	default:
+		return fmt.Errorf("log_format inválido '%s' (use json ou text)", c.LogFormat)
+	}
+
+	if c.LogMaxSizeMB < 0 || c.LogRotateHours < 0 || c.LogMaxAgeDays < 0 || c.LogMaxFiles < 0 {
+		return fmt.Errorf("log_max_size_mb, log_rotate_hours, log_max_age_days e log_max_files não podem ser negativos")
+	}
+
+	if c.QuotaBudget < 0 {
+		return fmt.Errorf("quota_budget não pode ser negativo")
+	}
+
+	if c.APITimeoutSeconds < 0 || c.JobTimeoutMinutes < 0 {
+		return fmt.Errorf("api_timeout_seconds e job_timeout_minutes não podem ser negativos")
+	}
+
+	if c.Profile == "" {
+		return fmt.Errorf("profile não pode ser vazio")
+	}
+
+	if c.CallbackAddress == "" {
+		return fmt.Errorf("callback_address não pode ser vazio")
+	}
+	if _, _, err := net.SplitHostPort(c.CallbackAddress); err != nil {
+		return fmt.Errorf("callback_address inválido '%s': use host:porta (ex.: 127.0.0.1:0)", c.CallbackAddress)
+	}
+
+	return nil
+}
+
+// DeviceClientSecretFile retorna as credenciais usadas no login por código de dispositivo.
+func (c Config) DeviceClientSecretFile() string {
+	if c.DeviceSecretFile != "" {
+		return c.DeviceSecretFile
+	}
+	return c.ClientSecretFile
+}
+
+// EffectiveLoginMethod resolve "auto": em sessões SSH não há navegador local para abrir,
+// então o login é feito colando a URL de retorno (manual).
+func (c Config) EffectiveLoginMethod() string {
+	if c.LoginMethod != "auto" {
+		return c.LoginMethod
+	}
+	if os.Getenv("SSH_CONNECTION") != "" || os.Getenv("SSH_TTY") != "" {
+		return "manual"
+	}
+	return "browser"
+}
+
+// APITimeout é o prazo de cada chamada à API (0 = sem prazo).
+func (c Config) APITimeout() time.Duration {
+	return time.Duration(c.APITimeoutSeconds) * time.Second
+}
+
+// JobTimeout é o prazo de uma busca ou gravação inteira (0 = sem prazo).
+func (c Config) JobTimeout() time.Duration {
+	return time.Duration(c.JobTimeoutMinutes) * time.Minute
+}
+
+// LogRotation converte as opções de rotação para a política do logger.
+func (c Config) LogRotation() logger.RotationPolicy {
+	return logger.RotationPolicy{
+		MaxSizeBytes: int64(c.LogMaxSizeMB) * 1024 * 1024,
+		RotateEvery:  time.Duration(c.LogRotateHours) * time.Hour,
+		MaxAge:       time.Duration(c.LogMaxAgeDays) * 24 * time.Hour,
+		MaxFiles:     c.LogMaxFiles,
+		Compress:     c.LogCompress,
+	}
+}
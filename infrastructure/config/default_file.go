@@ -0,0 +1,129 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/template"
+)
+
+var defaultFileTemplate = template.Must(template.New("config").Parse(`# Configuração do reorder-playlist.
+#
+# Ordem de precedência: este arquivo → variáveis de ambiente REORDER_PLAYLIST_<CHAVE>
+# (ex.: REORDER_PLAYLIST_PRIVACY=private) → flags antes do comando (ex.: --privacy private).
+# Caminhos relativos são resolvidos a partir do diretório deste arquivo. Os padrões seguem
+# a especificação XDG: $XDG_CONFIG_HOME, $XDG_STATE_HOME, $XDG_DATA_HOME e $XDG_CACHE_HOME.
+
+# Credenciais OAuth2 baixadas do Google Cloud Console
+client_secret_file = '{{.ClientSecretFile}}'
+
+# Onde o token OAuth2 do perfil "default" é salvo após o login; os demais perfis
+# ficam em profiles/<nome>/token.json, no mesmo diretório do token_file
+token_file = '{{.TokenFile}}'
+
+# Perfil (conta Google ou canal de marca) usado ao iniciar
+profile = "{{.Profile}}"
+
+# Armazenamento dos tokens: "plain" (JSON em texto puro, permissão 0600) ou "encrypted"
+# (Argon2id + AES-GCM). A senha vem de REORDER_PLAYLIST_TOKEN_PASSPHRASE ou é pedida no
+# terminal. Para converter tokens existentes rode o comando migrate-tokens.
+token_backend = "{{.TokenBackend}}"
+
+# Como fazer login: "browser" abre o navegador e recebe o callback local; "manual" mostra a
+# URL e aceita a URL de retorno (ou o código) colada na tela; "device" usa o fluxo de código
+# de dispositivo (digite o código em google.com/device em outro aparelho). "auto" usa
+# "manual" em sessões SSH e "browser" no resto.
+login_method = "{{.LoginMethod}}"
+
+# Credenciais para o login "device". O Google só aceita esse fluxo com clientes do tipo
+# "TV e dispositivos de entrada limitada"; vazio usa client_secret_file.
+device_client_secret_file = '{{.DeviceSecretFile}}'
+
+# Endereço do servidor local que recebe o callback do OAuth2. Com porta 0 uma porta livre é
+# escolhida a cada login e o redirect URI é montado com ela (clientes "Desktop" do Google
+# aceitam qualquer porta de loopback). Use uma porta fixa só se o seu cliente exigir.
+callback_address = "{{.CallbackAddress}}"
+
+# Diretório e nível mínimo dos logs (trace, debug, info, warning, error). Componentes podem
+# ter nível próprio: "info,provider=debug,tui=warning" (componentes: provider, tui,
+# usecases, auth, server, cli, token_manager, main...).
+log_dir = '{{.LogDir}}'
+log_level = "{{.LogLevel}}"
+
+# Formato dos arquivos de log: json (uma linha JSON por registro)
+# ou text (chave=valor, mais fácil de ler com tail/less)
+log_format = "{{.LogFormat}}"
+
+# Rotação e retenção dos logs: um arquivo novo a cada log_max_size_mb ou log_rotate_hours,
+# arquivos mais antigos que log_max_age_days apagados, no máximo log_max_files mantidos e,
+# com log_compress, os que saem de uso comprimidos com gzip. 0 desativa cada critério.
+log_max_size_mb = {{.LogMaxSizeMB}}
+log_rotate_hours = {{.LogRotateHours}}
+log_max_age_days = {{.LogMaxAgeDays}}
+log_max_files = {{.LogMaxFiles}}
+log_compress = {{.LogCompress}}
+
+# Critério pré-selecionado no menu de reordenação (name, duration, language, publish
+# ou o nome de um plugin/script de ordenação)
+default_sort = "{{.DefaultSort}}"
+
+# Privacidade das playlists criadas no YouTube: public, unlisted ou private
+privacy = "{{.Privacy}}"
+
+# Título sugerido ao salvar uma playlist reordenada (text/template).
+# Campos disponíveis: {{"{{"}}.Title{{"}}"}} (título original), {{"{{"}}.Criteria{{"}}"}} (critério) e {{"{{"}}.Date{{"}}"}} (AAAA-MM-DD).
+# Exemplo: "{{"{{"}}.Title{{"}}"}} ({{"{{"}}.Criteria{{"}}"}})"
+title_template = "{{.TitleTemplate}}"
+
+# Máximo de unidades de quota da YouTube Data API gastas por execução (0 = sem limite).
+# Leituras custam 1 unidade, escritas (inserir/remover itens, criar playlists) custam 50.
+quota_budget = {{.QuotaBudget}}
+
+# Prazos das operações remotas (0 = sem prazo): api_timeout_seconds vale para cada chamada
+# à API do YouTube e job_timeout_minutes para a busca ou gravação inteira. Na TUI, Esc
+# cancela a busca ou gravação em andamento; uma gravação para antes da escrita seguinte.
+api_timeout_seconds = {{.APITimeoutSeconds}}
+job_timeout_minutes = {{.JobTimeoutMinutes}}
+
+# Diário das operações remotas (buscas e gravações): início, fim, erro, cancelamento e
+# prazo esgotado, uma linha JSON por evento. Consulte com o comando jobs.
+job_journal_file = '{{.JobJournalFile}}'
+
+# Armazenamento local
+snapshots_dir = '{{.SnapshotsDir}}'
+smart_playlists_file = '{{.SmartPlaylistsFile}}'
+sorter_plugins_dir = '{{.SorterPluginsDir}}'
+scripts_dir = '{{.ScriptsDir}}'
+
+# Cache dos metadados dos vídeos (título, duração, idioma...), economiza quota da API.
+# Deixe vazio para desativar.
+cache_dir = '{{.CacheDir}}'
+`))
+
+// WriteDefault grava em path um arquivo de configuração comentado com os valores padrão.
+// Um arquivo existente só é sobrescrito com force.
+func WriteDefault(path string, force bool) error {
+	if !force {
+		if _, err := os.Stat(path); err == nil {
+			return fmt.Errorf("o arquivo %s já existe (use --force para sobrescrever)", path)
+		}
+	}
+
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("falha ao criar o diretório %s: %w", dir, err)
+		}
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("falha ao criar o arquivo de configuração %s: %w", path, err)
+	}
+	defer file.Close()
+
+	if err := defaultFileTemplate.Execute(file, Default()); err != nil {
+		return fmt.Errorf("falha ao escrever o arquivo de configuração %s: %w", path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// Migration descreve um arquivo ou diretório movido da localização antiga para a do XDG.
+type Migration struct {
+	From string
+	To   string
+}
+
+// legacyMarkers identificam um diretório onde versões anteriores rodavam: elas só
+// funcionavam a partir da raiz do repositório, com as credenciais dentro da árvore de código.
+var legacyMarkers = []string{
+	filepath.Join("infrastructure", "auth", "client_secret.json"),
+	filepath.Join("infrastructure", "token_manager", "token.json"),
+}
+
+// MigrateLegacy move os arquivos das localizações antigas (relativas ao diretório atual)
+// para as localizações do XDG. Só migra o que ainda está no caminho padrão e cujo destino
+// não existe, então rodar de novo não faz nada.
+func MigrateLegacy(cfg Config) ([]Migration, error) {
+	if !hasLegacyLayout() {
+		return nil, nil
+	}
+
+	defaults := Default()
+	candidates := []struct {
+		legacy  string
+		current string
+		def     string
+	}{
+		{legacyMarkers[0], cfg.ClientSecretFile, defaults.ClientSecretFile},
+		{legacyMarkers[1], cfg.TokenFile, defaults.TokenFile},
+		{"logs", cfg.LogDir, defaults.LogDir},
+		{"snapshots", cfg.SnapshotsDir, defaults.SnapshotsDir},
+		{"smart_playlists.json", cfg.SmartPlaylistsFile, defaults.SmartPlaylistsFile},
+		{filepath.Join("plugins", "sorters"), cfg.SorterPluginsDir, defaults.SorterPluginsDir},
+		{"scripts", cfg.ScriptsDir, defaults.ScriptsDir},
+	}
+
+	var migrations []Migration
+	for _, candidate := range candidates {
+		// o usuário escolheu outro caminho; não mexemos em nada
+		if candidate.current != candidate.def {
+			continue
+		}
+		if _, err := os.Stat(candidate.legacy); err != nil {
+			continue
+		}
+		if _, err := os.Stat(candidate.current); err == nil {
+			continue
+		}
+
+		if err := move(candidate.legacy, candidate.current); err != nil {
+			return migrations, fmt.Errorf("falha ao migrar %s para %s: %w", candidate.legacy, candidate.current, err)
+		}
+		migrations = append(migrations, Migration{From: candidate.legacy, To: candidate.current})
+	}
+
+	return migrations, nil
+}
+
+func hasLegacyLayout() bool {
+	for _, marker := range legacyMarkers {
+		if _, err := os.Stat(marker); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// move renomeia src para dst; se estiverem em sistemas de arquivos diferentes, copia e remove a origem.
+func move(src, dst string) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+
+	if err := os.Rename(src, dst); err == nil {
+		return nil
+	}
+
+	err := filepath.WalkDir(src, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return os.MkdirAll(target, info.Mode().Perm())
+		}
+		return copyFile(path, target, info.Mode().Perm())
+	})
+	if err != nil {
+		return err
+	}
+
+	return os.RemoveAll(src)
+}
+
+func copyFile(src, dst string, perm fs.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, perm)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,45 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// appDirName é o subdiretório da aplicação dentro de cada diretório base do XDG.
+const appDirName = "reorder-playlist"
+
+// xdgDir segue a XDG Base Directory Specification: usa a variável de ambiente quando
+// ela contém um caminho absoluto e, caso contrário, o padrão relativo ao $HOME.
+func xdgDir(env string, fallback ...string) string {
+	if dir := os.Getenv(env); filepath.IsAbs(dir) {
+		return filepath.Join(dir, appDirName)
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		// sem $HOME não há para onde ir além do diretório atual
+		return filepath.Join(".", appDirName)
+	}
+
+	return filepath.Join(append(append([]string{home}, fallback...), appDirName)...)
+}
+
+// ConfigDir guarda as credenciais OAuth2 e as configurações ($XDG_CONFIG_HOME).
+func ConfigDir() string {
+	return xdgDir("XDG_CONFIG_HOME", ".config")
+}
+
+// StateDir guarda tokens e logs ($XDG_STATE_HOME).
+func StateDir() string {
+	return xdgDir("XDG_STATE_HOME", ".local", "state")
+}
+
+// DataDir guarda dados criados pelo usuário: snapshots, plugins e scripts ($XDG_DATA_HOME).
+func DataDir() string {
+	return xdgDir("XDG_DATA_HOME", ".local", "share")
+}
+
+// CacheDir guarda metadados que podem ser baixados novamente ($XDG_CACHE_HOME).
+func CacheDir() string {
+	return xdgDir("XDG_CACHE_HOME", ".cache")
+}
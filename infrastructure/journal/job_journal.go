@@ -0,0 +1,104 @@
+package journal
+
+import (
+	"TUI_playlist_reorder/internal/core/domain"
+	"TUI_playlist_reorder/internal/core/ports"
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// record é o formato de cada linha do arquivo.
+type record struct {
+	OperationID string    `json:"op_id"`
+	Name        string    `json:"name"`
+	Status      string    `json:"status"`
+	At          time.Time `json:"at"`
+	DurationMS  int64     `json:"duration_ms,omitempty"`
+	Err         string    `json:"err,omitempty"`
+}
+
+type jobJournal struct {
+	filePath string
+	mu       sync.Mutex
+}
+
+// NewJobJournal acrescenta os eventos ao arquivo, uma linha JSON por evento.
+func NewJobJournal(filePath string) ports.JobJournalPort {
+	if filePath == "" {
+		filePath = "jobs.jsonl"
+	}
+
+	return &jobJournal{filePath: filePath}
+}
+
+func (j *jobJournal) Record(event domain.JobEvent) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if dir := filepath.Dir(j.filePath); dir != "" {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("falha ao criar o diretório do diário de operações %s: %w", dir, err)
+		}
+	}
+
+	file, err := os.OpenFile(j.filePath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("falha ao abrir o diário de operações %s: %w", j.filePath, err)
+	}
+	defer file.Close()
+
+	line := record{
+		OperationID: event.OperationID,
+		Name:        event.Name,
+		Status:      string(event.Status),
+		At:          event.At,
+		DurationMS:  event.Duration.Milliseconds(),
+		Err:         event.Err,
+	}
+	if err := json.NewEncoder(file).Encode(line); err != nil {
+		return fmt.Errorf("falha ao gravar no diário de operações %s: %w", j.filePath, err)
+	}
+	return nil
+}
+
+// Events lê o diário inteiro; linhas que não são JSON (arquivo truncado, por exemplo) são
+// ignoradas.
+func (j *jobJournal) Events() ([]domain.JobEvent, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	file, err := os.Open(j.filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("falha ao abrir o diário de operações %s: %w", j.filePath, err)
+	}
+	defer file.Close()
+
+	var events []domain.JobEvent
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var line record
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			continue
+		}
+		events = append(events, domain.JobEvent{
+			OperationID: line.OperationID,
+			Name:        line.Name,
+			Status:      domain.JobStatus(line.Status),
+			At:          line.At,
+			Duration:    time.Duration(line.DurationMS) * time.Millisecond,
+			Err:         line.Err,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("falha ao ler o diário de operações %s: %w", j.filePath, err)
+	}
+	return events, nil
+}
@@ -0,0 +1,66 @@
+package logger
+
+import (
+	"encoding/json"
+	"log/slog"
+	"time"
+)
+
+// LogData é uma linha do arquivo de log JSON. Os atributos estruturados que não são
+// campos fixos (playlist_id, video_id, duration_ms...) ficam em Attrs.
+type LogData struct {
+	Time      time.Time      `json:"time"`
+	Level     string         `json:"level"`
+	Source    *slog.Source   `json:"source,omitempty"`
+	Message   string         `json:"msg"`
+	Component string         `json:"component,omitempty"`
+	Err       string         `json:"err,omitempty"`
+	Operation LogOperation   `json:"op"`
+	Attrs     map[string]any `json:"-"`
+}
+
+// LogOperation é o grupo "op" gravado nas linhas de uma operação (ver domain.Operation).
+type LogOperation struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// ParseLogLine lê uma linha JSON gravada pelo logger. Linhas do formato anterior ao slog
+// (message, timestamp, file, function) também são aceitas.
+func ParseLogLine(line []byte) (LogData, error) {
+	var raw map[string]any
+	if err := json.Unmarshal(line, &raw); err != nil {
+		return LogData{}, err
+	}
+
+	var data LogData
+	if err := json.Unmarshal(line, &data); err != nil {
+		return LogData{}, err
+	}
+
+	// formato anterior
+	if data.Message == "" {
+		data.Message, _ = raw["message"].(string)
+	}
+	if data.Time.IsZero() {
+		if ts, ok := raw["timestamp"].(string); ok {
+			data.Time, _ = time.Parse(time.RFC3339, ts)
+		}
+	}
+	if data.Source == nil {
+		file, _ := raw["file"].(string)
+		function, _ := raw["function"].(string)
+		if file != "" || function != "" {
+			data.Source = &slog.Source{File: file, Function: function}
+		}
+	}
+
+	for _, key := range []string{"time", "level", "source", "msg", "component", "err", "op", "message", "timestamp", "file", "function"} {
+		delete(raw, key)
+	}
+	if len(raw) > 0 {
+		data.Attrs = raw
+	}
+
+	return data, nil
+}
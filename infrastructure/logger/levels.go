@@ -0,0 +1,106 @@
+package logger
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+)
+
+// LevelTrace fica abaixo do Debug do slog, para detalhes como cada página e cada vídeo.
+const LevelTrace = slog.Level(-8)
+
+// levelOrder define a severidade de cada nível; mensagens abaixo do nível mínimo são descartadas
+var levelOrder = map[string]slog.Level{
+	"TRACE":   LevelTrace,
+	"DEBUG":   slog.LevelDebug,
+	"INFO":    slog.LevelInfo,
+	"WARNING": slog.LevelWarn,
+	"ERROR":   slog.LevelError,
+}
+
+// LevelName devolve o nome usado nos arquivos (TRACE, DEBUG, INFO, WARNING, ERROR).
+func LevelName(level slog.Level) string {
+	switch {
+	case level < slog.LevelDebug:
+		return "TRACE"
+	case level < slog.LevelInfo:
+		return "DEBUG"
+	case level < slog.LevelWarn:
+		return "INFO"
+	case level < slog.LevelError:
+		return "WARNING"
+	default:
+		return "ERROR"
+	}
+}
+
+// LevelValue é o inverso de LevelName; nomes desconhecidos contam como INFO.
+func LevelValue(name string) slog.Level {
+	if level, ok := levelOrder[strings.ToUpper(name)]; ok {
+		return level
+	}
+	return slog.LevelInfo
+}
+
+// LevelSpec é o nível mínimo padrão mais exceções por componente (o último elemento do
+// pacote que chamou o logger: provider, tui, usecases, auth, cli, main...).
+type LevelSpec struct {
+	Default    slog.Level
+	Components map[string]slog.Level
+}
+
+// ParseLevelSpec interpreta "info" ou "info,provider=debug,tui=warning": itens sem "="
+// definem o nível padrão e os demais o nível de um componente.
+func ParseLevelSpec(spec string) (LevelSpec, error) {
+	parsed := LevelSpec{Default: slog.LevelInfo, Components: map[string]slog.Level{}}
+
+	for _, item := range strings.Split(spec, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		component, levelName, hasComponent := strings.Cut(item, "=")
+		if !hasComponent {
+			levelName = component
+		}
+
+		level, ok := levelOrder[strings.ToUpper(strings.TrimSpace(levelName))]
+		if !ok {
+			return LevelSpec{}, fmt.Errorf("nível de log desconhecido '%s' (use trace, debug, info, warning ou error)", strings.TrimSpace(levelName))
+		}
+
+		if !hasComponent {
+			parsed.Default = level
+			continue
+		}
+
+		component = strings.ToLower(strings.TrimSpace(component))
+		if component == "" {
+			return LevelSpec{}, fmt.Errorf("componente vazio em '%s'", item)
+		}
+		parsed.Components[component] = level
+	}
+
+	return parsed, nil
+}
+
+// enabled indica se uma mensagem do nível e componente informados deve ser gravada.
+func (s LevelSpec) enabled(level slog.Level, component string) bool {
+	if min, ok := s.Components[component]; ok {
+		return level >= min
+	}
+	return level >= s.Default
+}
+
+// lowest é o menor nível habilitado em algum componente, usado para descartar mensagens
+// sem precisar descobrir quem chamou.
+func (s LevelSpec) lowest() slog.Level {
+	lowest := s.Default
+	for _, level := range s.Components {
+		if level < lowest {
+			lowest = level
+		}
+	}
+	return lowest
+}
@@ -1,120 +1,326 @@
 package logger
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
+	"io"
+	"log/slog"
 	"os"
 	"path/filepath"
 	"runtime"
 	"strings"
 	"sync"
 	"time"
+
+	"TUI_playlist_reorder/internal/core/domain"
 )
 
+// Logger grava mensagens com atributos estruturados no formato chave/valor do slog:
+//
+//	log.Info("Playlist reordenada", "playlist_id", id, "criteria", criteria, "duration_ms", ms)
+//
+// As variantes ...Context acrescentam o grupo "op" ({id, name}) com a operação do contexto
+// (domain.StartOperation), sem que quem chama precise montá-lo.
 type Logger interface {
-	Info(msg string)
-	Error(msg string, err error)
-	Warning(msg string)
+	Trace(msg string, attrs ...any)
+	Debug(msg string, attrs ...any)
+	Info(msg string, attrs ...any)
+	Error(msg string, err error, attrs ...any)
+	Warning(msg string, attrs ...any)
+	TraceContext(ctx context.Context, msg string, attrs ...any)
+	DebugContext(ctx context.Context, msg string, attrs ...any)
+	InfoContext(ctx context.Context, msg string, attrs ...any)
+	ErrorContext(ctx context.Context, msg string, err error, attrs ...any)
+	WarningContext(ctx context.Context, msg string, attrs ...any)
+	// CurrentFile é o caminho do arquivo em uso, que muda a cada rotação.
+	CurrentFile() string
 	Close()
 }
 
-type LogData struct {
-	File      string `json:"file"`
-	Function  string `json:"function"`
-	Level     string `json:"level"`
-	Message   string `json:"message"`
-	Err       string `json:"err,omitempty"`
-	Timestamp string `json:"timestamp"`
-}
+// Formatos aceitos por WithFormat
+const (
+	FormatJSON = "json"
+	FormatText = "text"
+)
 
 type fileLogger struct {
 	mu        sync.Mutex
+	levels    LevelSpec
 	logFile   *os.File
-	encoder   *json.Encoder
+	handler   slog.Handler
+	format    string
 	logDir    string
 	logPrefix string
+	redact    []RedactionHook
+	mirror    io.Writer
+
+	rotation RotationPolicy
+	written  int64
+	openedAt time.Time
+	closed   map[string]bool // arquivos que este processo já fechou numa rotação
 }
 
-func NewFileLogger(logDir, logPrefix string) (Logger, error) {
-	if err := os.MkdirAll(logDir, 0755); err != nil {
-		return nil, fmt.Errorf("falha ao criar o diretório de log '%s': %w", logDir, err)
+// Option ajusta o logger criado por NewFileLogger.
+type Option func(*fileLogger)
+
+// WithRedaction adiciona um hook de redação depois de RedactSecrets.
+func WithRedaction(hook RedactionHook) Option {
+	return func(l *fileLogger) {
+		l.redact = append(l.redact, hook)
 	}
+}
 
-	timestamp := time.Now().Format("2006-01-02_15-04-05")
-	logFileName := fmt.Sprintf("%s_%s.json", logPrefix, timestamp)
+// WithMirror copia avisos e erros para w (o stderr no modo CLI), em texto, além do arquivo.
+func WithMirror(w io.Writer) Option {
+	return func(l *fileLogger) {
+		l.mirror = w
+	}
+}
 
-	logFilePath := filepath.Join(logDir, logFileName)
+// WithFormat escolhe o handler do slog usado no arquivo: FormatJSON (padrão) ou FormatText.
+func WithFormat(format string) Option {
+	return func(l *fileLogger) {
+		l.format = format
+	}
+}
 
-	file, err := os.OpenFile(logFilePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+// NewFileLogger cria o logger sobre log/slog. levelSpec segue ParseLevelSpec ("info",
+// "info,provider=debug"...). Mensagens, erros e atributos de texto passam por RedactSecrets
+// e pelos hooks de WithRedaction antes de serem gravados, para que segredos nunca cheguem
+// ao arquivo.
+func NewFileLogger(logDir, logPrefix, levelSpec string, opts ...Option) (Logger, error) {
+	levels, err := ParseLevelSpec(levelSpec)
 	if err != nil {
-		return nil, fmt.Errorf("falha ao abrir/criar o arquivo de log '%s': %w", logFilePath, err)
+		return nil, err
 	}
 
-	return &fileLogger{
-		logFile:   file,
-		encoder:   json.NewEncoder(file),
+	l := &fileLogger{
+		levels:    levels,
+		format:    FormatJSON,
 		logDir:    logDir,
 		logPrefix: logPrefix,
-	}, nil
+		redact:    []RedactionHook{RedactSecrets},
+	}
+	for _, opt := range opts {
+		opt(l)
+	}
+
+	if l.format != FormatJSON && l.format != FormatText {
+		return nil, fmt.Errorf("formato de log desconhecido '%s' (use json ou text)", l.format)
+	}
+
+	if err := os.MkdirAll(logDir, 0755); err != nil {
+		return nil, fmt.Errorf("falha ao criar o diretório de log '%s': %w", logDir, err)
+	}
+
+	file, err := openLogFile(logDir, logPrefix, l.fileExt())
+	if err != nil {
+		return nil, err
+	}
+	l.setFile(file)
+
+	if l.rotation != (RotationPolicy{}) {
+		l.applyRetention()
+	}
+
+	return l, nil
 }
 
-func (l *fileLogger) writeLogInternal(level string, msg string, errIn error, skip int) {
-	l.mu.Lock()
-	defer l.mu.Unlock()
+// newHandler cria o handler do slog para o arquivo atual; trocar de formato é só trocar o handler.
+func (l *fileLogger) newHandler(w io.Writer) slog.Handler {
+	opts := &slog.HandlerOptions{
+		AddSource:   true,
+		Level:       LevelTrace, // a filtragem por componente é feita antes, em log
+		ReplaceAttr: l.replaceAttr,
+	}
 
-	if l.logFile == nil {
-		fmt.Fprintf(os.Stderr, "Logger está fechado, não é possível escrever log: %s\n", msg)
-		return
+	if l.format == FormatText {
+		return slog.NewTextHandler(w, opts)
 	}
+	return slog.NewJSONHandler(w, opts)
+}
 
-	pc, filePath, line, ok := runtime.Caller(skip)
+// replaceAttr dá nome aos níveis próprios (TRACE, WARNING), encurta o caminho do arquivo
+// de origem e aplica a redação em todo valor de texto.
+func (l *fileLogger) replaceAttr(groups []string, a slog.Attr) slog.Attr {
+	switch {
+	case a.Key == slog.LevelKey && len(groups) == 0:
+		if level, ok := a.Value.Any().(slog.Level); ok {
+			return slog.String(slog.LevelKey, LevelName(level))
+		}
+	case a.Key == slog.SourceKey && len(groups) == 0:
+		if src, ok := a.Value.Any().(*slog.Source); ok {
+			short := *src
+			short.File = filepath.Base(src.File)
+			short.Function = src.Function[strings.LastIndex(src.Function, "/")+1:]
+			return slog.Any(slog.SourceKey, &short)
+		}
+	}
 
-	var funcName string
-	var shortFileName string
+	if isSecretKey(a.Key) {
+		return slog.String(a.Key, Redacted)
+	}
 
-	if ok {
-		shortFileName = filepath.Base(filePath)
-		fn := runtime.FuncForPC(pc)
-		if fn != nil {
-			parts := strings.Split(fn.Name(), ".")
-			funcName = parts[len(parts)-1]
-		} else {
-			funcName = "???"
+	switch a.Value.Kind() {
+	case slog.KindString:
+		return slog.String(a.Key, l.redacted(a.Value.String()))
+	case slog.KindAny:
+		if err, ok := a.Value.Any().(error); ok {
+			return slog.String(a.Key, l.redacted(err.Error()))
 		}
+		if s, ok := a.Value.Any().(fmt.Stringer); ok {
+			return slog.String(a.Key, l.redacted(s.String()))
+		}
+	}
+	return a
+}
 
-		_ = line
-	} else {
-		shortFileName = "???"
-		funcName = "???"
+// componentOf extrai o último elemento do pacote do nome completo da função
+// ("TUI_playlist_reorder/infrastructure/provider.(*youtubeProvider).GetMyChannel" → "provider").
+func componentOf(funcName string) string {
+	pkg := funcName[strings.LastIndex(funcName, "/")+1:]
+	if dot := strings.Index(pkg, "."); dot >= 0 {
+		pkg = pkg[:dot]
 	}
+	return pkg
+}
+
+// log monta o registro do slog com a origem (arquivo, função e linha) de quem chamou
+// Info/Error/etc., o componente usado nos filtros de nível e a operação de ctx.
+func (l *fileLogger) log(ctx context.Context, level slog.Level, msg string, errIn error, attrs []any) {
+	if level < l.levels.lowest() {
+		return
+	}
+
+	var pcs [1]uintptr
+	runtime.Callers(3, pcs[:]) // runtime.Callers, log e o método público
 
-	logEntry := LogData{
-		Timestamp: time.Now().Format(time.RFC3339), // Para o conteúdo do log, RFC3339 é bom
-		File:      shortFileName,
-		Function:  funcName,
-		Level:     level,
-		Message:   msg,
+	component := "???"
+	if frame, _ := runtime.CallersFrames(pcs[:]).Next(); frame.Function != "" {
+		component = componentOf(frame.Function)
 	}
 
+	if !l.levels.enabled(level, component) {
+		return
+	}
+
+	record := slog.NewRecord(time.Now(), level, msg, pcs[0])
+	record.AddAttrs(slog.String("component", component))
 	if errIn != nil {
-		logEntry.Err = errIn.Error()
+		record.AddAttrs(slog.String("err", errIn.Error()))
+	}
+	if op, ok := domain.OperationFrom(ctx); ok {
+		record.AddAttrs(slog.Group("op", slog.String("id", op.ID), slog.String("name", op.Name)))
+	}
+	record.Add(attrs...)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.logFile == nil {
+		fmt.Fprintf(os.Stderr, "Logger está fechado, não é possível escrever log: %s\n", l.redacted(msg))
+		return
 	}
 
-	if err := l.encoder.Encode(logEntry); err != nil {
+	if err := l.handler.Handle(ctx, record); err != nil {
 		fmt.Fprintf(os.Stderr, "Falha ao escrever log no arquivo: %v\n", err)
 	}
+
+	if l.mirror != nil && level >= slog.LevelWarn {
+		l.writeMirror(record, component)
+	}
+
+	if l.needsRotation() {
+		l.rotate()
+	}
 }
 
-func (l *fileLogger) Info(msg string) {
-	l.writeLogInternal("INFO", msg, nil, 2) // Níveis de log em maiúsculo por convenção
+// writeMirror escreve uma linha curta e legível: "ERROR [cli] mensagem: erro chave=valor".
+func (l *fileLogger) writeMirror(record slog.Record, component string) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s [%s] %s", LevelName(record.Level), component, l.redacted(record.Message))
+
+	record.Attrs(func(a slog.Attr) bool {
+		a = l.replaceAttr(nil, a)
+		switch a.Key {
+		case "component":
+		case "op":
+			// só o ID, que é o que se usa no comando logs
+			if a.Value.Kind() != slog.KindGroup {
+				fmt.Fprintf(&b, " op=%v", a.Value)
+				break
+			}
+			for _, member := range a.Value.Group() {
+				// erros marcados com domain.TagError já trazem "[op <id>]"
+				if member.Key == "id" && !strings.Contains(b.String(), "[op "+member.Value.String()+"]") {
+					fmt.Fprintf(&b, " op=%s", member.Value)
+				}
+			}
+		case "err":
+			b.WriteString(": " + a.Value.String())
+		default:
+			fmt.Fprintf(&b, " %s=%v", a.Key, a.Value)
+		}
+		return true
+	})
+
+	fmt.Fprintln(l.mirror, b.String())
 }
 
-func (l *fileLogger) Error(msg string, err error) {
-	l.writeLogInternal("ERROR", msg, err, 2)
+func (l *fileLogger) redacted(text string) string {
+	for _, hook := range l.redact {
+		text = hook(text)
+	}
+	return text
 }
 
-func (l *fileLogger) Warning(msg string) {
-	l.writeLogInternal("WARNING", msg, nil, 2)
+func (l *fileLogger) Trace(msg string, attrs ...any) {
+	l.log(context.Background(), LevelTrace, msg, nil, attrs)
+}
+
+func (l *fileLogger) Debug(msg string, attrs ...any) {
+	l.log(context.Background(), slog.LevelDebug, msg, nil, attrs)
+}
+
+func (l *fileLogger) Info(msg string, attrs ...any) {
+	l.log(context.Background(), slog.LevelInfo, msg, nil, attrs)
+}
+
+func (l *fileLogger) Error(msg string, err error, attrs ...any) {
+	l.log(context.Background(), slog.LevelError, msg, err, attrs)
+}
+
+func (l *fileLogger) Warning(msg string, attrs ...any) {
+	l.log(context.Background(), slog.LevelWarn, msg, nil, attrs)
+}
+
+func (l *fileLogger) TraceContext(ctx context.Context, msg string, attrs ...any) {
+	l.log(ctx, LevelTrace, msg, nil, attrs)
+}
+
+func (l *fileLogger) DebugContext(ctx context.Context, msg string, attrs ...any) {
+	l.log(ctx, slog.LevelDebug, msg, nil, attrs)
+}
+
+func (l *fileLogger) InfoContext(ctx context.Context, msg string, attrs ...any) {
+	l.log(ctx, slog.LevelInfo, msg, nil, attrs)
+}
+
+func (l *fileLogger) ErrorContext(ctx context.Context, msg string, err error, attrs ...any) {
+	l.log(ctx, slog.LevelError, msg, err, attrs)
+}
+
+func (l *fileLogger) WarningContext(ctx context.Context, msg string, attrs ...any) {
+	l.log(ctx, slog.LevelWarn, msg, nil, attrs)
+}
+
+func (l *fileLogger) CurrentFile() string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.logFile == nil {
+		return ""
+	}
+	return l.logFile.Name()
 }
 
 func (l *fileLogger) Close() {
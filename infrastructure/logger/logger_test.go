@@ -0,0 +1,59 @@
+package logger
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"TUI_playlist_reorder/internal/core/domain"
+)
+
+func TestContextLoggingAddsOperation(t *testing.T) {
+	dir := t.TempDir()
+	var mirror strings.Builder
+	l, err := NewFileLogger(dir, "app", "info", WithMirror(&mirror))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := domain.StartOperation(context.Background(), "reorder")
+	op, _ := domain.OperationFrom(ctx)
+	l.InfoContext(ctx, "com operação", "playlist_id", "PL1")
+	l.WarningContext(context.Background(), "contexto sem operação")
+	l.Info("sem contexto")
+	l.ErrorContext(ctx, "falhou", errors.New("quota"))
+	l.Close()
+
+	lines := strings.Split(strings.TrimSpace(readLogs(t, dir)), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("linhas gravadas = %d, esperado 4:\n%s", len(lines), strings.Join(lines, "\n"))
+	}
+
+	var first struct {
+		Msg        string `json:"msg"`
+		PlaylistID string `json:"playlist_id"`
+		Op         struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"op"`
+	}
+	if err := json.Unmarshal([]byte(lines[0]), &first); err != nil {
+		t.Fatal(err)
+	}
+	if first.Op.ID != op.ID || first.Op.Name != "reorder" || first.PlaylistID != "PL1" {
+		t.Errorf("linha com operação = %s", lines[0])
+	}
+	for _, line := range lines[1:3] {
+		if strings.Contains(line, `"op"`) {
+			t.Errorf("linha sem operação no contexto trouxe op: %s", line)
+		}
+	}
+
+	// o espelho em texto mostra só o ID da operação
+	want := "WARNING [logger] contexto sem operação\nERROR [logger] falhou: quota op=" + op.ID + "\n"
+	if mirror.String() != want {
+		t.Errorf("espelho = %q, esperado %q", mirror.String(), want)
+	}
+}
@@ -0,0 +1,178 @@
+package logger
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// OperationLog reúne, em ordem cronológica, as linhas de uma operação.
+type OperationLog struct {
+	ID      string
+	Name    string
+	Entries []LogData
+}
+
+func (o OperationLog) Start() time.Time {
+	return o.Entries[0].Time
+}
+
+func (o OperationLog) Duration() time.Duration {
+	return o.Entries[len(o.Entries)-1].Time.Sub(o.Start())
+}
+
+// Errors conta as linhas de nível ERROR.
+func (o OperationLog) Errors() int {
+	count := 0
+	for _, entry := range o.Entries {
+		if entry.Level == "ERROR" {
+			count++
+		}
+	}
+	return count
+}
+
+// ReadLogDir lê as linhas de todos os arquivos de log JSON do diretório, inclusive os
+// rotacionados e comprimidos, em ordem cronológica. Arquivos no formato text e linhas que
+// não são JSON são ignorados.
+func ReadLogDir(dir string) ([]LogData, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao ler o diretório de log '%s': %w", dir, err)
+	}
+
+	var lines []LogData
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !(strings.HasSuffix(name, ".json") || strings.HasSuffix(name, ".json.gz")) {
+			continue
+		}
+
+		fileLines, err := readLogFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, fileLines...)
+	}
+
+	sort.SliceStable(lines, func(i, j int) bool { return lines[i].Time.Before(lines[j].Time) })
+	return lines, nil
+}
+
+func readLogFile(path string) ([]LogData, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var r io.Reader = file
+	if strings.HasSuffix(path, ".gz") {
+		gz, err := gzip.NewReader(file)
+		if err != nil {
+			return nil, fmt.Errorf("falha ao descomprimir %s: %w", path, err)
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	var lines []LogData
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		data, err := ParseLogLine(scanner.Bytes())
+		if err != nil {
+			continue
+		}
+		lines = append(lines, data)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("falha ao ler %s: %w", path, err)
+	}
+	return lines, nil
+}
+
+// TailFile lê as linhas completas gravadas em path a partir de offset e devolve o offset
+// seguinte, para acompanhar o arquivo em uso. Linhas que não são JSON (formato text)
+// voltam só com Message preenchida.
+func TailFile(path string, offset int64) ([]LogData, int64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, offset, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, offset, err
+	}
+	if info.Size() < offset {
+		// arquivo truncado ou recriado: recomeça do início
+		offset = 0
+	}
+	if info.Size() == offset {
+		return nil, offset, nil
+	}
+
+	buf := make([]byte, info.Size()-offset)
+	n, err := file.ReadAt(buf, offset)
+	if err != nil && err != io.EOF {
+		return nil, offset, err
+	}
+	buf = buf[:n]
+
+	// a última linha pode estar pela metade; fica para a próxima leitura
+	end := bytes.LastIndexByte(buf, '\n')
+	if end < 0 {
+		return nil, offset, nil
+	}
+
+	var lines []LogData
+	for _, raw := range bytes.Split(buf[:end], []byte("\n")) {
+		if len(bytes.TrimSpace(raw)) == 0 {
+			continue
+		}
+		data, err := ParseLogLine(raw)
+		if err != nil {
+			data = LogData{Message: string(raw)}
+		}
+		lines = append(lines, data)
+	}
+
+	return lines, offset + int64(end) + 1, nil
+}
+
+// GroupByOperation agrupa as linhas (em ordem cronológica, como ReadLogDir devolve) pelo ID
+// da operação; as operações ficam na ordem em que começaram. Linhas fora de uma operação
+// ficam de fora.
+func GroupByOperation(lines []LogData) []OperationLog {
+	byID := make(map[string]*OperationLog)
+	var order []string
+
+	for _, line := range lines {
+		id := line.Operation.ID
+		if id == "" {
+			continue
+		}
+		op, ok := byID[id]
+		if !ok {
+			op = &OperationLog{ID: id, Name: line.Operation.Name}
+			byID[id] = op
+			order = append(order, id)
+		}
+		op.Entries = append(op.Entries, line)
+	}
+
+	operations := make([]OperationLog, 0, len(order))
+	for _, id := range order {
+		operations = append(operations, *byID[id])
+	}
+	return operations
+}
@@ -0,0 +1,53 @@
+package logger
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Redacted substitui os valores sensíveis removidos das mensagens de log.
+const Redacted = "[REDACTED]"
+
+// RedactionHook recebe o texto de uma mensagem (ou erro) antes de ir para o arquivo e
+// devolve a versão sem segredos. NewFileLogger sempre aplica RedactSecrets antes dos hooks
+// adicionados com WithRedaction.
+type RedactionHook func(string) string
+
+// secretKeys são os nomes de campos cujo valor nunca deve ser logado.
+const secretKeys = `code|access_token|refresh_token|id_token|device_code|code_verifier|client_secret|token|accesstoken|refreshtoken`
+
+var secretPatterns = []struct {
+	pattern     *regexp.Regexp
+	replacement string
+}{
+	// cabeçalho Authorization (Bearer, Basic ou valor cru)
+	{regexp.MustCompile(`(?i)(authorization["']?\s*[:=]\s*["']?)(?:(?:bearer|basic)\s+)?[^\s"',}]+`), "${1}" + Redacted},
+	// chave=valor em URLs, formulários e mensagens ("Code=...", "?code=...&state=")
+	{regexp.MustCompile(`(?i)\b(` + secretKeys + `)=[^\s"'&,;}]+`), "${1}=" + Redacted},
+	// "chave": "valor" em JSON (só com aspas, para não apagar mensagens como "token: falha ...")
+	{regexp.MustCompile(`(?i)("(?:` + secretKeys + `)"\s*:\s*")[^"]*`), "${1}" + Redacted},
+	// formatos dos tokens do Google, caso apareçam soltos no texto
+	{regexp.MustCompile(`\bya29\.[A-Za-z0-9_\-.]+`), Redacted}, // access token
+	{regexp.MustCompile(`\b1//[A-Za-z0-9_\-]+`), Redacted},     // refresh token
+	{regexp.MustCompile(`\b4/[A-Za-z0-9_\-]{10,}`), Redacted},  // código de autorização
+}
+
+// RedactSecrets remove códigos de autorização, access/refresh tokens e cabeçalhos
+// Authorization do texto.
+func RedactSecrets(text string) string {
+	for _, secret := range secretPatterns {
+		text = secret.pattern.ReplaceAllString(text, secret.replacement)
+	}
+	return text
+}
+
+// isSecretKey indica se o nome de um atributo estruturado é de um valor sensível
+// ("code", "access_token"...), caso em que o valor inteiro é omitido.
+func isSecretKey(key string) bool {
+	for _, secret := range strings.Split(secretKeys, "|") {
+		if strings.EqualFold(key, secret) {
+			return true
+		}
+	}
+	return strings.EqualFold(key, "authorization")
+}
@@ -0,0 +1,109 @@
+package logger
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const (
+	testAccessToken  = "ya29.a0AfB_byC-secretAccessToken123"
+	testRefreshToken = "1//0gSecretRefreshToken-xyz"
+	testAuthCode     = "4/0AeanS0abcdefghijSecretCode"
+	testVerifier     = "verifierSecretValue0123456789"
+	testClientSecret = "GOCSPX-clientSecretValue"
+)
+
+var allSecrets = []string{testAccessToken, testRefreshToken, testAuthCode, testVerifier, testClientSecret, "opaqueHeaderValue"}
+
+// readLogs lê todos os arquivos JSON gravados no diretório de log.
+func readLogs(t *testing.T, dir string) string {
+	t.Helper()
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("nenhum arquivo de log foi criado")
+	}
+
+	var b strings.Builder
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b.Write(data)
+	}
+	return b.String()
+}
+
+func TestSecretsNeverReachLogFile(t *testing.T) {
+	dir := t.TempDir()
+	log, err := NewFileLogger(dir, "test", "info")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	log.Info("Resultado do callback: Code=" + testAuthCode + ", Err=<nil>")
+	log.Info("callback GET /?state=abc&code=" + testAuthCode + "&scope=youtube")
+	log.Info("POST /token grant_type=refresh_token&refresh_token=" + testRefreshToken + "&client_secret=" + testClientSecret)
+	log.Info("exchange code_verifier=" + testVerifier)
+	log.Warning("Authorization: Bearer " + testAccessToken)
+	log.Warning("headers: map[Authorization:[opaqueHeaderValue]]")
+	log.Error("token inválido", errors.New(`oauth2: "invalid_grant" {"access_token": "`+testAccessToken+`", "refresh_token":"`+testRefreshToken+`"}`))
+	log.Error("falha na requisição", errors.New("Get https://x?access_token="+testAccessToken+": 401"))
+	log.Info("token solto no texto " + testAccessToken + " e " + testRefreshToken + " e " + testAuthCode)
+	log.Info("troca do código", "code", testAuthCode, "redirect", "http://127.0.0.1/?code="+testAuthCode)
+	log.Warning("falha ao renovar", "err", errors.New("refresh_token="+testRefreshToken), "access_token", testAccessToken)
+	log.Close()
+
+	content := readLogs(t, dir)
+	for _, secret := range allSecrets {
+		if strings.Contains(content, secret) {
+			t.Errorf("o segredo %q foi gravado no log:\n%s", secret, content)
+		}
+	}
+	if !strings.Contains(content, Redacted) {
+		t.Errorf("esperava marcações %s no log:\n%s", Redacted, content)
+	}
+}
+
+func TestRedactionKeepsRegularMessages(t *testing.T) {
+	messages := []string{
+		"Token existente encontrado, navegando para Playlists",
+		"não foi possível carregar o token: falha ao abrir arquivo de token /tmp/token.json",
+		"Resultado do callback: código recebido=true, Err=<nil>",
+		"Playlist PL123 reordenada por name",
+	}
+
+	for _, msg := range messages {
+		if got := RedactSecrets(msg); got != msg {
+			t.Errorf("RedactSecrets alterou uma mensagem sem segredos:\n  antes: %s\n  depois: %s", msg, got)
+		}
+	}
+}
+
+func TestCustomRedactionHook(t *testing.T) {
+	dir := t.TempDir()
+	hook := func(text string) string {
+		return strings.ReplaceAll(text, "canal-privado", Redacted)
+	}
+
+	log, err := NewFileLogger(dir, "test", "info", WithRedaction(hook))
+	if err != nil {
+		t.Fatal(err)
+	}
+	log.Info("sincronizando canal-privado com Code=" + testAuthCode)
+	log.Close()
+
+	content := readLogs(t, dir)
+	for _, secret := range []string{"canal-privado", testAuthCode} {
+		if strings.Contains(content, secret) {
+			t.Errorf("o valor %q foi gravado no log:\n%s", secret, content)
+		}
+	}
+}
@@ -0,0 +1,270 @@
+package logger
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// RotationPolicy controla quando o arquivo atual é trocado por um novo e quantos
+// arquivos antigos são mantidos. Valores zero desativam o critério correspondente.
+type RotationPolicy struct {
+	MaxSizeBytes int64         // troca o arquivo ao passar deste tamanho
+	RotateEvery  time.Duration // troca o arquivo depois deste tempo aberto
+	MaxAge       time.Duration // apaga arquivos modificados há mais tempo que isso
+	MaxFiles     int           // total de arquivos mantidos, contando o atual
+	Compress     bool          // comprime com gzip os arquivos que não estão mais em uso
+}
+
+// defaultActiveWindow é por quanto tempo depois da última escrita um arquivo de log pode
+// ainda estar aberto por outra instância, quando a política não rotaciona por tempo.
+const defaultActiveWindow = 24 * time.Hour
+
+// WithRotation ativa a rotação e a retenção dos arquivos de log. A limpeza também roda na
+// abertura do logger, então os arquivos de execuções anteriores entram na política.
+func WithRotation(policy RotationPolicy) Option {
+	return func(l *fileLogger) {
+		l.rotation = policy
+	}
+}
+
+// countingWriter conta os bytes gravados no arquivo atual para a rotação por tamanho.
+type countingWriter struct {
+	w io.Writer
+	n *int64
+}
+
+func (c countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	*c.n += int64(n)
+	return n, err
+}
+
+// fileExt é a extensão dos arquivos do formato atual.
+func (l *fileLogger) fileExt() string {
+	if l.format == FormatText {
+		return ".log"
+	}
+	return ".json"
+}
+
+// openLogFile cria um arquivo novo <prefix>_<timestamp><ext>; se o nome já existir, mesmo
+// comprimido (duas rotações no mesmo segundo), acrescenta um sufixo numérico.
+func openLogFile(logDir, logPrefix, ext string) (*os.File, error) {
+	timestamp := time.Now().Format("2006-01-02_15-04-05")
+
+	for seq := 0; ; seq++ {
+		logFileName := fmt.Sprintf("%s_%s%s", logPrefix, timestamp, ext)
+		if seq > 0 {
+			logFileName = fmt.Sprintf("%s_%s_%d%s", logPrefix, timestamp, seq, ext)
+		}
+		logFilePath := filepath.Join(logDir, logFileName)
+		if _, err := os.Stat(logFilePath + ".gz"); err == nil {
+			continue
+		}
+
+		file, err := os.OpenFile(logFilePath, os.O_APPEND|os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+		if os.IsExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("falha ao abrir/criar o arquivo de log '%s': %w", logFilePath, err)
+		}
+		return file, nil
+	}
+}
+
+// needsRotation é chamada com o lock do logger adquirido.
+func (l *fileLogger) needsRotation() bool {
+	if l.rotation.MaxSizeBytes > 0 && l.written >= l.rotation.MaxSizeBytes {
+		return true
+	}
+	return l.rotation.RotateEvery > 0 && time.Since(l.openedAt) >= l.rotation.RotateEvery
+}
+
+// rotate fecha o arquivo atual, abre um novo e aplica a retenção. Chamada com o lock adquirido.
+func (l *fileLogger) rotate() {
+	file, err := openLogFile(l.logDir, l.logPrefix, l.fileExt())
+	if err != nil {
+		// sem arquivo novo, continua escrevendo no atual
+		fmt.Fprintf(os.Stderr, "Falha ao rotacionar o log: %v\n", err)
+		return
+	}
+
+	if err := l.logFile.Close(); err != nil {
+		fmt.Fprintf(os.Stderr, "Erro ao fechar arquivo de log: %v\n", err)
+	}
+	if l.closed == nil {
+		l.closed = make(map[string]bool)
+	}
+	l.closed[l.logFile.Name()] = true
+
+	l.setFile(file)
+	l.applyRetention()
+}
+
+func (l *fileLogger) setFile(file *os.File) {
+	l.logFile = file
+	l.written = 0
+	l.openedAt = time.Now()
+	l.handler = l.newHandler(countingWriter{w: file, n: &l.written})
+}
+
+// applyRetention comprime (se configurado) e apaga os arquivos antigos do prefixo, nunca
+// mexendo no arquivo atual nem no que pode ser o atual de outra instância.
+func (l *fileLogger) applyRetention() {
+	if err := enforceRetention(l.logDir, l.logPrefix, l.logFile.Name(), l.closed, l.rotation); err != nil {
+		fmt.Fprintf(os.Stderr, "Falha ao aplicar a retenção de logs: %v\n", err)
+	}
+}
+
+// activeWindow é por quanto tempo depois da última escrita um arquivo pode continuar em uso
+// por outra instância: com rotação por tempo ela troca de arquivo antes de escrever de novo.
+func (p RotationPolicy) activeWindow() time.Duration {
+	if p.RotateEvery > 0 {
+		return p.RotateEvery
+	}
+	return defaultActiveWindow
+}
+
+type logFileInfo struct {
+	path    string
+	modTime time.Time
+}
+
+// enforceRetention aplica a política aos arquivos do prefixo, exceto current. Arquivos sem
+// compressão modificados dentro de activeWindow que este processo não fechou (closed) podem
+// ser o arquivo atual de outra instância usando o mesmo log_dir: ficam como estão e contam
+// como mantidos.
+func enforceRetention(logDir, logPrefix, current string, closed map[string]bool, policy RotationPolicy) error {
+	entries, err := os.ReadDir(logDir)
+	if err != nil {
+		return err
+	}
+
+	var files []logFileInfo
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, logPrefix+"_") {
+			continue
+		}
+		if !isLogFileName(name) {
+			continue
+		}
+
+		path := filepath.Join(logDir, name)
+		if path == current {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		files = append(files, logFileInfo{path: path, modTime: info.ModTime()})
+	}
+
+	// mais recentes primeiro
+	sort.Slice(files, func(i, j int) bool { return files[i].modTime.After(files[j].modTime) })
+
+	var errs []string
+	kept := 1 // o arquivo atual
+	for _, file := range files {
+		inUse := !strings.HasSuffix(file.path, ".gz") && !closed[file.path] &&
+			time.Since(file.modTime) < policy.activeWindow()
+		if inUse {
+			kept++
+			continue
+		}
+
+		expired := policy.MaxAge > 0 && time.Since(file.modTime) > policy.MaxAge
+		overLimit := policy.MaxFiles > 0 && kept >= policy.MaxFiles
+
+		if expired || overLimit {
+			if err := os.Remove(file.path); err != nil {
+				errs = append(errs, err.Error())
+			} else {
+				delete(closed, file.path)
+			}
+			continue
+		}
+		kept++
+
+		if policy.Compress && !strings.HasSuffix(file.path, ".gz") {
+			if err := gzipFile(file.path); err != nil {
+				errs = append(errs, err.Error())
+			} else {
+				delete(closed, file.path)
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// isLogFileName aceita os arquivos dos dois formatos, comprimidos ou não.
+func isLogFileName(name string) bool {
+	for _, ext := range []string{".json", ".log", ".json.gz", ".log.gz"} {
+		if strings.HasSuffix(name, ext) {
+			return true
+		}
+	}
+	return false
+}
+
+// gzipFile grava <path>.gz preservando a data de modificação e remove o original.
+func gzipFile(path string) error {
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	info, err := src.Stat()
+	if err != nil {
+		return err
+	}
+
+	tmp := path + ".gz.tmp"
+	dst, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+
+	gz := gzip.NewWriter(dst)
+	gz.Name = filepath.Base(path)
+	gz.ModTime = info.ModTime()
+
+	_, copyErr := io.Copy(gz, src)
+	closeErr := gz.Close()
+	fileErr := dst.Close()
+	if err := firstError(copyErr, closeErr, fileErr); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("falha ao comprimir %s: %w", path, err)
+	}
+
+	if err := os.Rename(tmp, path+".gz"); err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+	_ = os.Chtimes(path+".gz", info.ModTime(), info.ModTime())
+
+	return os.Remove(path)
+}
+
+func firstError(errs ...error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,234 @@
+package logger
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+// writeLogFile cria um arquivo de log com a data de modificação informada.
+func writeLogFile(t *testing.T, dir, name string, modTime time.Time) string {
+	t.Helper()
+
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(`{"msg":"`+name+`"}`+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func listDir(t *testing.T, dir string) []string {
+	t.Helper()
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestEnforceRetention(t *testing.T) {
+	now := time.Now()
+	old := now.Add(-48 * time.Hour)
+
+	tests := []struct {
+		name   string
+		policy RotationPolicy
+		files  map[string]time.Time
+		closed []string
+		want   []string
+	}{
+		{
+			name:   "limite de arquivos apaga os mais antigos",
+			policy: RotationPolicy{MaxFiles: 3},
+			files: map[string]time.Time{
+				"app_1.json": old.Add(-3 * time.Hour),
+				"app_2.json": old.Add(-2 * time.Hour),
+				"app_3.json": old.Add(-time.Hour),
+				"app_4.json": old,
+			},
+			want: []string{"app_3.json", "app_4.json", "atual.json"},
+		},
+		{
+			name:   "idade máxima",
+			policy: RotationPolicy{MaxAge: 72 * time.Hour},
+			files: map[string]time.Time{
+				"app_1.json.gz": now.Add(-100 * time.Hour),
+				"app_2.log":     now.Add(-73 * time.Hour),
+				"app_3.json":    old,
+			},
+			want: []string{"app_3.json", "atual.json"},
+		},
+		{
+			name:   "comprime os que saíram de uso",
+			policy: RotationPolicy{Compress: true},
+			files: map[string]time.Time{
+				"app_1.json":    old,
+				"app_2.json.gz": old,
+			},
+			want: []string{"app_1.json.gz", "app_2.json.gz", "atual.json"},
+		},
+		{
+			name:   "não mexe no atual de outra instância",
+			policy: RotationPolicy{MaxFiles: 2, MaxAge: time.Minute, Compress: true},
+			files: map[string]time.Time{
+				"app_1.json": old,
+				"app_2.json": now.Add(-2 * time.Minute),
+			},
+			want: []string{"app_2.json", "atual.json"},
+		},
+		{
+			name:   "janela de uso segue a rotação por tempo",
+			policy: RotationPolicy{RotateEvery: time.Hour, Compress: true},
+			files: map[string]time.Time{
+				"app_1.json": now.Add(-2 * time.Hour),
+				"app_2.json": now.Add(-10 * time.Minute),
+			},
+			want: []string{"app_1.json.gz", "app_2.json", "atual.json"},
+		},
+		{
+			name:   "arquivo fechado por este processo é comprimido logo",
+			policy: RotationPolicy{Compress: true},
+			files: map[string]time.Time{
+				"app_1.json": now,
+			},
+			closed: []string{"app_1.json"},
+			want:   []string{"app_1.json.gz", "atual.json"},
+		},
+		{
+			name:   "ignora outros prefixos e extensões",
+			policy: RotationPolicy{MaxFiles: 1},
+			files: map[string]time.Time{
+				"outro_1.json": old,
+				"app_1.txt":    old,
+				"app_2.json":   old,
+			},
+			want: []string{"app_1.txt", "atual.json", "outro_1.json"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			current := writeLogFile(t, dir, "atual.json", now)
+			for name, modTime := range tt.files {
+				writeLogFile(t, dir, name, modTime)
+			}
+			closed := make(map[string]bool)
+			for _, name := range tt.closed {
+				closed[filepath.Join(dir, name)] = true
+			}
+
+			// "atual.json" não tem o prefixo; o arquivo atual é protegido pelo caminho
+			if err := enforceRetention(dir, "app", current, closed, tt.policy); err != nil {
+				t.Fatalf("enforceRetention() erro = %v", err)
+			}
+			if got := listDir(t, dir); strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("arquivos = %v, esperado %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGzipFilePreservesContentAndModTime(t *testing.T) {
+	dir := t.TempDir()
+	modTime := time.Now().Add(-time.Hour).Truncate(time.Second)
+	path := writeLogFile(t, dir, "app_1.json", modTime)
+
+	if err := gzipFile(path); err != nil {
+		t.Fatalf("gzipFile() erro = %v", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("original continua existindo: %v", err)
+	}
+
+	info, err := os.Stat(path + ".gz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !info.ModTime().Equal(modTime) {
+		t.Errorf("data de modificação = %v, esperado %v", info.ModTime(), modTime)
+	}
+
+	file, err := os.Open(path + ".gz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := io.ReadAll(gz)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"msg":"app_1.json"}`+"\n" {
+		t.Errorf("conteúdo descomprimido = %q", data)
+	}
+}
+
+func TestLoggerRotatesBySize(t *testing.T) {
+	dir := t.TempDir()
+	l, err := NewFileLogger(dir, "app", "info", WithRotation(RotationPolicy{MaxSizeBytes: 200, MaxFiles: 3, Compress: true}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	for i := 0; i < 20; i++ {
+		l.Info("mensagem longa o bastante para encher o arquivo", "i", i)
+	}
+
+	names := listDir(t, dir)
+	if len(names) != 3 {
+		t.Fatalf("arquivos = %v, esperado 3 (MaxFiles)", names)
+	}
+	var plain int
+	for _, name := range names {
+		if !strings.HasSuffix(name, ".json.gz") {
+			plain++
+		}
+	}
+	// só o arquivo em uso fica sem compressão; os que este processo fechou são comprimidos
+	if plain != 1 {
+		t.Errorf("arquivos sem compressão = %d em %v, esperado só o atual", plain, names)
+	}
+}
+
+func TestLoggerKeepsAnotherInstanceFile(t *testing.T) {
+	dir := t.TempDir()
+	policy := RotationPolicy{MaxFiles: 1, Compress: true}
+
+	other, err := NewFileLogger(dir, "app", "info", WithRotation(policy))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer other.Close()
+	other.Info("outra instância")
+
+	l, err := NewFileLogger(dir, "app", "info", WithRotation(policy))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	// o arquivo da primeira instância continua aberto e sem compressão
+	other.Info("ainda escrevendo")
+	if got := readLogs(t, dir); !strings.Contains(got, "ainda escrevendo") {
+		t.Errorf("escrita da outra instância perdida; logs:\n%s", got)
+	}
+}
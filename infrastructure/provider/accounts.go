@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"TUI_playlist_reorder/infrastructure/token_manager"
+	"TUI_playlist_reorder/internal/core/ports"
+	"sync"
+	"time"
+)
+
+// accountRegistry cria (uma única vez) um provider autenticado para cada perfil.
+type accountRegistry struct {
+	profiles    token_manager.ProfileStore
+	log         ports.LoggerPort
+	privacy     string
+	quotaBudget int
+	callTimeout time.Duration
+	cacheDir    string
+
+	mu        sync.Mutex
+	providers map[string]ports.YoutubePort
+}
+
+func NewAccountRegistry(profiles token_manager.ProfileStore, logger ports.LoggerPort, privacy string, quotaBudget int, callTimeout time.Duration, cacheDir string) ports.AccountPort {
+	return &accountRegistry{
+		profiles:    profiles,
+		log:         logger,
+		privacy:     privacy,
+		quotaBudget: quotaBudget,
+		callTimeout: callTimeout,
+		cacheDir:    cacheDir,
+		providers:   make(map[string]ports.YoutubePort),
+	}
+}
+
+func (r *accountRegistry) ListProfiles() ([]string, error) {
+	return r.profiles.List()
+}
+
+func (r *accountRegistry) HasToken(profile string) bool {
+	return r.profiles.Exists(profile)
+}
+
+func (r *accountRegistry) YoutubeFor(profile string) (ports.YoutubePort, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if provider, ok := r.providers[profile]; ok {
+		return provider, nil
+	}
+
+	tokenService, err := r.profiles.TokenService(profile)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := NewYoutubeProvider(tokenService, r.log, r.privacy, r.quotaBudget, r.callTimeout, r.cacheDir)
+	r.providers[profile] = provider
+	return provider, nil
+}
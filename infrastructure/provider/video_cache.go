@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"TUI_playlist_reorder/internal/core/domain"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// videoCacheTTL limita por quanto tempo os metadados em cache são considerados válidos.
+const videoCacheTTL = 7 * 24 * time.Hour
+
+// videoCache guarda os detalhes de cada vídeo em um arquivo JSON por ID, evitando
+// uma chamada a Videos.List (e a quota correspondente) para vídeos já conhecidos.
+// Com dir vazio o cache fica desativado.
+type videoCache struct {
+	dir string
+}
+
+func newVideoCache(dir string) videoCache {
+	if dir == "" {
+		return videoCache{}
+	}
+	return videoCache{dir: filepath.Join(dir, "videos")}
+}
+
+func (c videoCache) path(videoID string) string {
+	return filepath.Join(c.dir, videoID+".json")
+}
+
+func (c videoCache) get(videoID string) (domain.Video, bool) {
+	if c.dir == "" {
+		return domain.Video{}, false
+	}
+
+	path := c.path(videoID)
+	info, err := os.Stat(path)
+	if err != nil || time.Since(info.ModTime()) > videoCacheTTL {
+		return domain.Video{}, false
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return domain.Video{}, false
+	}
+
+	var video domain.Video
+	if err := json.Unmarshal(data, &video); err != nil {
+		return domain.Video{}, false
+	}
+
+	return video, true
+}
+
+// put grava o vídeo no cache; falhas são ignoradas, o cache é apenas uma otimização.
+func (c videoCache) put(video domain.Video) {
+	if c.dir == "" {
+		return
+	}
+
+	if err := os.MkdirAll(c.dir, 0755); err != nil {
+		return
+	}
+
+	video.ItemID = ""
+	data, err := json.Marshal(video)
+	if err != nil {
+		return
+	}
+
+	_ = os.WriteFile(c.path(video.ID), data, 0644)
+}
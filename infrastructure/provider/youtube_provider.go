@@ -5,6 +5,7 @@ import (
 	"TUI_playlist_reorder/internal/core/domain"
 	"TUI_playlist_reorder/internal/core/ports"
 	"context"
+	"errors"
 	"fmt"
 	"github.com/sosodev/duration"
 	"golang.org/x/oauth2"
@@ -15,43 +16,103 @@ import (
 	"time"
 )
 
+// Custo em unidades de quota da YouTube Data API v3
+const (
+	quotaCostRead  = 1
+	quotaCostWrite = 50
+)
+
+var errQuotaExceeded = errors.New("quota budget exceeded")
+
 type youtubeProvider struct {
 	tokenService token_manager.TokenService
 	log          ports.LoggerPort
 	service      *youtube.Service
 	mu           sync.Mutex
+
+	privacy     string
+	quotaBudget int
+	quotaUsed   int
+	quotaMu     sync.Mutex
+
+	callTimeout time.Duration
+
+	cache videoCache
 }
 
-func NewYoutubeProvider(tokenService token_manager.TokenService, logger ports.LoggerPort) ports.YoutubePort {
+// NewYoutubeProvider cria o provider; privacy é aplicada às playlists criadas,
+// quotaBudget limita as unidades de quota gastas (0 = sem limite), callTimeout é o prazo
+// de cada chamada à API (0 = sem prazo) e cacheDir guarda os metadados dos vídeos entre
+// execuções (vazio desativa).
+func NewYoutubeProvider(tokenService token_manager.TokenService, logger ports.LoggerPort, privacy string, quotaBudget int, callTimeout time.Duration, cacheDir string) ports.YoutubePort {
 	return &youtubeProvider{
 		tokenService: tokenService,
 		log:          logger,
 		service:      nil,
 		mu:           sync.Mutex{},
+		privacy:      privacy,
+		quotaBudget:  quotaBudget,
+		callTimeout:  callTimeout,
+		cache:        newVideoCache(cacheDir),
 	}
 }
 
+// callContext limita uma chamada à API ao prazo configurado; o cancelamento da operação
+// (ctx) também interrompe a chamada.
+func (s *youtubeProvider) callContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if s.callTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, s.callTimeout)
+}
+
+// writeContext é o callContext das escritas: o cancelamento e o prazo da operação não
+// interrompem uma escrita já enviada, que poderia ficar aplicada sem que o programa saiba.
+// Eles são vistos por spendQuota antes da escrita seguinte.
+func (s *youtubeProvider) writeContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return s.callContext(context.WithoutCancel(ctx))
+}
+
+// spendQuota reserva as unidades de uma chamada, falhando antes de chamar a API se o orçamento acabou
+// ou se a operação já foi cancelada.
+func (s *youtubeProvider) spendQuota(ctx context.Context, units int) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	s.quotaMu.Lock()
+	defer s.quotaMu.Unlock()
+
+	if s.quotaBudget > 0 && s.quotaUsed+units > s.quotaBudget {
+		return fmt.Errorf("%w: %d of %d units used", errQuotaExceeded, s.quotaUsed, s.quotaBudget)
+	}
+
+	s.quotaUsed += units
+	s.log.DebugContext(ctx, "Quota spent", "units", units, "quota_used", s.quotaUsed)
+	return nil
+}
+
 func (s *youtubeProvider) getYoutubeService(ctx context.Context) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	token, err := s.tokenService.LoadToken()
 	if err != nil {
-		s.log.Error("error while load token: %w", err)
-		return fmt.Errorf("error while load token: %w", err)
+		s.log.ErrorContext(ctx, "error while load token", err)
+		return domain.TagError(ctx, fmt.Errorf("error while load token: %w", err))
 	}
 
-	s.log.Info("Load token completed")
+	s.log.DebugContext(ctx, "Load token completed")
 
 	service, err := youtube.NewService(ctx, option.WithTokenSource(oauth2.StaticTokenSource(token)))
 	if err != nil {
-		s.log.Error("error while create youtube service: %w", err)
-		return fmt.Errorf("error while create youtube service: %w", err)
+		s.log.ErrorContext(ctx, "error while create youtube service", err)
+		return domain.TagError(ctx, fmt.Errorf("error while create youtube service: %w", err))
 	}
 
 	s.service = service
 
-	s.log.Info("Create youtube service completed")
+	s.log.DebugContext(ctx, "Create youtube service completed")
 
 	return nil
 }
@@ -60,24 +121,30 @@ func (s *youtubeProvider) GetAllPlaylistsFromUser(ctx context.Context) ([]domain
 	if s.service == nil {
 		err := s.getYoutubeService(ctx)
 		if err != nil {
-			s.log.Error("error while get youtube service: %w", err)
-			return nil, fmt.Errorf("error while create youtube provider: %w", err)
+			s.log.ErrorContext(ctx, "error while get youtube service", err)
+			return nil, domain.TagError(ctx, fmt.Errorf("error while create youtube provider: %w", err))
 		}
 	}
 
 	//preparando chamada para a api do YouTube
 	call := s.service.Playlists.List([]string{"id", "snippet", "contentDetails"}).Mine(true).MaxResults(50)
 
+	if err := s.spendQuota(ctx, quotaCostRead); err != nil {
+		return nil, domain.TagError(ctx, err)
+	}
+
 	//realizando a chamada para a api
-	response, err := call.Do()
+	callCtx, cancel := s.callContext(ctx)
+	response, err := call.Context(callCtx).Do()
+	cancel()
 	if err != nil {
-		s.log.Error("error while call youtube service: %w", err)
-		return nil, fmt.Errorf("error in call youtube api: %w", err)
+		s.log.ErrorContext(ctx, "error while call youtube service", err)
+		return nil, domain.TagError(ctx, fmt.Errorf("error in call youtube api: %w", err))
 	}
 
 	//verifica se a resposta veio vazia
 	if len(response.Items) == 0 {
-		s.log.Warning("No youtube playlists found")
+		s.log.WarningContext(ctx, "No youtube playlists found")
 		return []domain.Playlist{}, nil
 	}
 
@@ -86,8 +153,8 @@ func (s *youtubeProvider) GetAllPlaylistsFromUser(ctx context.Context) ([]domain
 	for i, item := range response.Items {
 		videos, err := s.getPlaylistVideos(item.Id, ctx)
 		if err != nil {
-			s.log.Error("error while get videos: %w", err)
-			return nil, fmt.Errorf("error in getPlaylistvideos while get videos: %w", err)
+			s.log.ErrorContext(ctx, "error while get videos", err, "playlist_id", item.Id)
+			return nil, domain.TagError(ctx, fmt.Errorf("error in getPlaylistvideos while get videos: %w", err))
 		}
 
 		playlistDomain[i] = domain.Playlist{
@@ -98,7 +165,7 @@ func (s *youtubeProvider) GetAllPlaylistsFromUser(ctx context.Context) ([]domain
 		}
 	}
 
-	defer s.log.Info("Get all playlists completed")
+	defer s.log.InfoContext(ctx, "Get all playlists completed")
 
 	return playlistDomain, nil
 }
@@ -107,24 +174,30 @@ func (s *youtubeProvider) GetPlaylistWithoutVideos(ctx context.Context) ([]domai
 	if s.service == nil {
 		err := s.getYoutubeService(ctx)
 		if err != nil {
-			s.log.Error("error while get youtube service: %w", err)
-			return nil, fmt.Errorf("error while create youtube provider: %w", err)
+			s.log.ErrorContext(ctx, "error while get youtube service", err)
+			return nil, domain.TagError(ctx, fmt.Errorf("error while create youtube provider: %w", err))
 		}
 	}
 
 	//preparando chamada para a api do YouTube
 	call := s.service.Playlists.List([]string{"id", "snippet", "contentDetails"}).Mine(true).MaxResults(50)
 
+	if err := s.spendQuota(ctx, quotaCostRead); err != nil {
+		return nil, domain.TagError(ctx, err)
+	}
+
 	//realizando a chamada para a api
-	response, err := call.Do()
+	callCtx, cancel := s.callContext(ctx)
+	response, err := call.Context(callCtx).Do()
+	cancel()
 	if err != nil {
-		s.log.Error("error while call youtube service: %w", err)
-		return nil, fmt.Errorf("error in call youtube api: %w", err)
+		s.log.ErrorContext(ctx, "error while call youtube service", err)
+		return nil, domain.TagError(ctx, fmt.Errorf("error in call youtube api: %w", err))
 	}
 
 	//verifica se a resposta veio vazia
 	if len(response.Items) == 0 {
-		s.log.Warning("No youtube playlists found")
+		s.log.WarningContext(ctx, "No youtube playlists found")
 		return []domain.Playlist{}, nil
 	}
 
@@ -140,7 +213,7 @@ func (s *youtubeProvider) GetPlaylistWithoutVideos(ctx context.Context) ([]domai
 		}
 	}
 
-	defer s.log.Info("Get all playlists completed")
+	defer s.log.InfoContext(ctx, "Get all playlists completed")
 
 	return playlistDomain, nil
 }
@@ -149,33 +222,38 @@ func (s *youtubeProvider) GetPlaylistByURL(playlistURL string, ctx context.Conte
 	if s.service == nil {
 		err := s.getYoutubeService(ctx)
 		if err != nil {
-			s.log.Error("error while get youtube service: %w", err)
-			return domain.Playlist{}, fmt.Errorf("error while create youtube provider: %w", err)
+			s.log.ErrorContext(ctx, "error while get youtube service", err)
+			return domain.Playlist{}, domain.TagError(ctx, fmt.Errorf("error while create youtube provider: %w", err))
 		}
 	}
 
 	parsedURL, err := url.Parse(playlistURL)
 	if err != nil {
-		return domain.Playlist{}, fmt.Errorf("error in parsing playlist url: %w", err)
+		return domain.Playlist{}, domain.TagError(ctx, fmt.Errorf("error in parsing playlist url: %w", err))
 	}
 
 	//buscando o playlist id baseado no parâmetro list da url
 	playlistID := parsedURL.Query().Get("list")
 	if playlistID == "" {
-		return domain.Playlist{}, fmt.Errorf("playlist id not exists")
+		return domain.Playlist{}, domain.TagError(ctx, fmt.Errorf("playlist id not exists"))
 	}
 
 	//pegando os dados dos videos
 	videos, err := s.getPlaylistVideos(playlistID, ctx)
 	if err != nil {
-		return domain.Playlist{}, fmt.Errorf("error in getPlaylistvideos while get videos: %w", err)
+		return domain.Playlist{}, domain.TagError(ctx, fmt.Errorf("error in getPlaylistvideos while get videos: %w", err))
 	}
 
 	//preparando a chamada para a api
 	call := s.service.Playlists.List([]string{"id", "snippet"}).Id(playlistID)
-	response, err := call.Do()
+	if err := s.spendQuota(ctx, quotaCostRead); err != nil {
+		return domain.Playlist{}, domain.TagError(ctx, err)
+	}
+	callCtx, cancel := s.callContext(ctx)
+	response, err := call.Context(callCtx).Do()
+	cancel()
 	if err != nil {
-		return domain.Playlist{}, fmt.Errorf("error in call youtube api: %w", err)
+		return domain.Playlist{}, domain.TagError(ctx, fmt.Errorf("error in call youtube api: %w", err))
 	}
 
 	//preparando o domain para retornar
@@ -193,27 +271,32 @@ func (s *youtubeProvider) GetPlaylistByID(playlistID string, ctx context.Context
 	if s.service == nil {
 		err := s.getYoutubeService(ctx)
 		if err != nil {
-			s.log.Error("error while get youtube service: %w", err)
-			return domain.Playlist{}, fmt.Errorf("error while create youtube provider: %w", err)
+			s.log.ErrorContext(ctx, "error while get youtube service", err)
+			return domain.Playlist{}, domain.TagError(ctx, fmt.Errorf("error while create youtube provider: %w", err))
 		}
 	}
 
 	//chama a api do youtube para pegar os dados da playlist
 	call := s.service.Playlists.List([]string{"id", "snippet"}).Id(playlistID)
-	response, err := call.Do()
+	if err := s.spendQuota(ctx, quotaCostRead); err != nil {
+		return domain.Playlist{}, domain.TagError(ctx, err)
+	}
+	callCtx, cancel := s.callContext(ctx)
+	response, err := call.Context(callCtx).Do()
+	cancel()
 	if err != nil {
-		return domain.Playlist{}, fmt.Errorf("error in call youtube api: %w", err)
+		return domain.Playlist{}, domain.TagError(ctx, fmt.Errorf("error in call youtube api: %w", err))
 	}
 
 	//verifica se a resposta veio vazia
 	if len(response.Items) == 0 {
-		return domain.Playlist{}, fmt.Errorf("playlist not found")
+		return domain.Playlist{}, domain.TagError(ctx, fmt.Errorf("playlist not found"))
 	}
 
 	//pega os videos da playlist
 	videos, err := s.getPlaylistVideos(playlistID, ctx)
 	if err != nil {
-		return domain.Playlist{}, fmt.Errorf("error in getPlaylistvideos while get videos: %w", err)
+		return domain.Playlist{}, domain.TagError(ctx, fmt.Errorf("error in getPlaylistvideos while get videos: %w", err))
 	}
 
 	item := response.Items[0]
@@ -233,22 +316,28 @@ func (s *youtubeProvider) DeletePlaylist(playlistID string, ctx context.Context)
 	if s.service == nil {
 		err := s.getYoutubeService(ctx)
 		if err != nil {
-			return fmt.Errorf("error while create youtube service: %w", err)
+			return domain.TagError(ctx, fmt.Errorf("error while create youtube service: %w", err))
 		}
 	}
 
-	err := s.service.Playlists.Delete(playlistID).Do()
+	if err := s.spendQuota(ctx, quotaCostWrite); err != nil {
+		return domain.TagError(ctx, err)
+	}
+
+	callCtx, cancel := s.writeContext(ctx)
+	err := s.service.Playlists.Delete(playlistID).Context(callCtx).Do()
+	cancel()
 	if err != nil {
-		return fmt.Errorf("error in call youtube api: %w", err)
+		return domain.TagError(ctx, fmt.Errorf("error in call youtube api: %w", err))
 	}
 
 	return nil
 }
 
-func (s *youtubeProvider) SavePlaylist(title string, playlist domain.Playlist, ctx context.Context) error {
+func (s *youtubeProvider) SavePlaylist(title string, playlist domain.Playlist, ctx context.Context) (string, error) {
 	if s.service == nil {
 		if err := s.getYoutubeService(ctx); err != nil {
-			return fmt.Errorf("error while create youtube service: %w", err)
+			return "", domain.TagError(ctx, fmt.Errorf("error while create youtube service: %w", err))
 		}
 	}
 
@@ -260,33 +349,293 @@ func (s *youtubeProvider) SavePlaylist(title string, playlist domain.Playlist, c
 				Description: "",
 			},
 			Status: &youtube.PlaylistStatus{
-				PrivacyStatus: "public",
+				PrivacyStatus: s.privacy,
 			},
 		},
-	).Context(ctx)
+	)
+
+	if err := s.spendQuota(ctx, quotaCostWrite); err != nil {
+		return "", domain.TagError(ctx, err)
+	}
 
-	newPlaylist, err := insertCall.Do()
+	callCtx, cancel := s.writeContext(ctx)
+	newPlaylist, err := insertCall.Context(callCtx).Do()
+	cancel()
 	if err != nil {
-		return fmt.Errorf("error while create playlist: %w", err)
+		return "", domain.TagError(ctx, fmt.Errorf("error while create playlist: %w", err))
 	}
 
 	newPlaylistID := newPlaylist.Id
-	s.log.Info(fmt.Sprintf("Playlist criada no YouTube com ID: %s", newPlaylistID))
+	s.log.InfoContext(ctx, "Playlist criada no YouTube", "playlist_id", newPlaylistID, "videos", len(playlist.Videos))
+
+	// a criação conta como o primeiro item da gravação
+	total := len(playlist.Videos) + 1
+	domain.ReportProgress(ctx, domain.ProgressEvent{Stage: domain.ProgressCreate, Done: 1, Total: total, Title: title})
+
+	for i, video := range playlist.Videos {
+		if _, err := s.addVideoToPlaylist(newPlaylistID, video.ID, ctx); err != nil {
+			return newPlaylistID, domain.TagError(ctx, fmt.Errorf("error while insert video in playlist: %w", err))
+		}
+		domain.ReportProgress(ctx, domain.ProgressEvent{Stage: domain.ProgressInsert, Done: i + 2, Total: total, Title: video.Title})
+	}
+
+	return newPlaylistID, nil
+}
+
+// ReplacePlaylistVideos deixa a playlist exatamente com os vídeos informados, na ordem
+// dada: os itens que não estão na lista são removidos. É a reconstrução de uma playlist
+// inteligente, cujo conteúdo vem todo da definição.
+func (s *youtubeProvider) ReplacePlaylistVideos(playlistID string, videos []domain.Video, ctx context.Context) error {
+	return s.writePlaylist(playlistID, videos, func(*youtube.PlaylistItem) bool { return true }, ctx)
+}
+
+// ReorderPlaylistVideos grava a ordem de uma sessão de reordenação. Só são removidos os
+// itens de removedItemIDs; os demais itens que não estão na lista (vídeos privados ou
+// indisponíveis que a leitura pulou, ou adicionados depois que a sessão começou) ficam na
+// playlist, na mesma posição.
+func (s *youtubeProvider) ReorderPlaylistVideos(playlistID string, videos []domain.Video, removedItemIDs []string, ctx context.Context) error {
+	removed := make(map[string]bool, len(removedItemIDs))
+	for _, id := range removedItemIDs {
+		removed[id] = true
+	}
+	return s.writePlaylist(playlistID, videos, func(item *youtube.PlaylistItem) bool { return removed[item.Id] }, ctx)
+}
+
+// writePlaylist leva a playlist à ordem dos vídeos informados sem esvaziá-la no caminho:
+// insere no fim os vídeos que faltam, remove os itens que sobram e que remove aceita e só
+// então reposiciona (PlaylistItems.Update) os itens fora do lugar. Numa reordenação os
+// itens são os mesmos e só os movidos gastam quota. Os vídeos são casados com os itens
+// atuais pelo ItemID e, sem ele, pelo ID do vídeo.
+func (s *youtubeProvider) writePlaylist(playlistID string, videos []domain.Video, remove func(*youtube.PlaylistItem) bool, ctx context.Context) error {
+	if s.service == nil {
+		if err := s.getYoutubeService(ctx); err != nil {
+			return domain.TagError(ctx, fmt.Errorf("error while create youtube service: %w", err))
+		}
+	}
+
+	// o snippet vem pelo mesmo custo e dá o título e o vídeo de cada item
+	var items []*youtube.PlaylistItem
+	pageToken := ""
+	for {
+		if err := s.spendQuota(ctx, quotaCostRead); err != nil {
+			return domain.TagError(ctx, err)
+		}
+
+		callCtx, cancel := s.callContext(ctx)
+		response, err := s.service.PlaylistItems.List([]string{"id", "snippet"}).PlaylistId(playlistID).MaxResults(50).PageToken(pageToken).Context(callCtx).Do()
+		cancel()
+		if err != nil {
+			return domain.TagError(ctx, fmt.Errorf("error in call youtube api: %w", err))
+		}
+
+		items = append(items, response.Items...)
+
+		if response.NextPageToken == "" {
+			break
+		}
+		pageToken = response.NextPageToken
+	}
+
+	plan := planReplace(items, videos, remove)
+	total := len(plan.inserts) + len(plan.deletes) + len(plan.moves)
+	done := 0
+
+	for _, insert := range plan.inserts {
+		itemID, err := s.addVideoToPlaylist(playlistID, insert.video.ID, ctx)
+		if err != nil {
+			return domain.TagError(ctx, fmt.Errorf("error while insert video in playlist (%d of %d writes done): %w", done, total, err))
+		}
+		plan.itemIDs[insert.placeholder] = itemID
+		done++
+		domain.ReportProgress(ctx, domain.ProgressEvent{Stage: domain.ProgressInsert, Done: done, Total: total, Title: insert.video.Title})
+	}
+
+	for _, item := range plan.deletes {
+		if err := s.DeletePlaylistItem(item.Id, ctx); err != nil {
+			return domain.TagError(ctx, fmt.Errorf("error while removing playlist item (%d of %d writes done): %w", done, total, err))
+		}
+		done++
+		domain.ReportProgress(ctx, domain.ProgressEvent{Stage: domain.ProgressRemove, Done: done, Total: total, Title: itemTitle(item)})
+	}
+
+	for _, move := range plan.moves {
+		itemID := plan.itemIDs[move.ItemID]
+		if err := s.movePlaylistItem(playlistID, itemID, plan.videoIDs[move.ItemID], move.Position, ctx); err != nil {
+			return domain.TagError(ctx, fmt.Errorf("error while moving playlist item (%d of %d writes done): %w", done, total, err))
+		}
+		done++
+		domain.ReportProgress(ctx, domain.ProgressEvent{Stage: domain.ProgressMove, Done: done, Total: total, Title: plan.titles[move.ItemID]})
+	}
+
+	s.log.InfoContext(ctx, "Playlist substituída", "playlist_id", playlistID, "videos", len(videos),
+		"inserted", len(plan.inserts), "removed", len(plan.deletes), "moved", len(plan.moves))
+
+	return nil
+}
+
+// pendingInsert é um vídeo que ainda não está na playlist; placeholder o representa no
+// plano de movimentos até a inserção devolver o ID do item.
+type pendingInsert struct {
+	placeholder string
+	video       domain.Video
+}
+
+// replacePlan é o que writePlaylist grava, calculado antes da primeira escrita.
+// As chaves dos mapas são os IDs dos itens atuais ou os placeholders das inserções.
+type replacePlan struct {
+	inserts  []pendingInsert
+	deletes  []*youtube.PlaylistItem
+	moves    []domain.ItemMove
+	itemIDs  map[string]string
+	videoIDs map[string]string
+	titles   map[string]string
+}
+
+// planReplace calcula as escritas que levam items à ordem de videos. Os itens que não casam
+// com nenhum vídeo são removidos quando remove os aceita; os outros continuam na playlist,
+// no mesmo índice em que estavam entre os itens mantidos.
+func planReplace(items []*youtube.PlaylistItem, videos []domain.Video, remove func(*youtube.PlaylistItem) bool) replacePlan {
+	plan := replacePlan{itemIDs: make(map[string]string), videoIDs: make(map[string]string), titles: make(map[string]string)}
 
-	for _, video := range playlist.Videos {
-		if err := s.addVideoToPlaylist(newPlaylistID, video.ID, ctx); err != nil {
-			return fmt.Errorf("error while insert video in playlist: %w", err)
+	byItemID := make(map[string]*youtube.PlaylistItem, len(items))
+	byVideoID := make(map[string][]*youtube.PlaylistItem)
+	for _, item := range items {
+		byItemID[item.Id] = item
+		videoID := itemVideoID(item)
+		byVideoID[videoID] = append(byVideoID[videoID], item)
+	}
+	used := make(map[string]bool, len(items))
+
+	take := func(video domain.Video) *youtube.PlaylistItem {
+		if item, ok := byItemID[video.ItemID]; ok && !used[item.Id] && itemVideoID(item) == video.ID {
+			return item
+		}
+		for _, item := range byVideoID[video.ID] {
+			if !used[item.Id] {
+				return item
+			}
 		}
+		return nil
+	}
+
+	target := make([]string, 0, len(videos))
+	for i, video := range videos {
+		key := ""
+		if item := take(video); item != nil {
+			used[item.Id] = true
+			key = item.Id
+		} else {
+			key = fmt.Sprintf("insert:%d", i)
+			plan.inserts = append(plan.inserts, pendingInsert{placeholder: key, video: video})
+		}
+		target = append(target, key)
+		plan.itemIDs[key] = key
+		plan.videoIDs[key] = video.ID
+		plan.titles[key] = video.Title
+	}
+
+	// depois das inserções (no fim) e das remoções, a ordem é a dos itens mantidos seguida
+	// das inserções; os movimentos partem dela
+	current := make([]string, 0, len(items)+len(plan.inserts))
+	for _, item := range items {
+		switch {
+		case used[item.Id]:
+			current = append(current, item.Id)
+		case remove(item):
+			plan.deletes = append(plan.deletes, item)
+		default:
+			// item que a lista não trouxe e que ninguém pediu para remover: fica onde está
+			index := min(len(current), len(target))
+			target = append(target[:index], append([]string{item.Id}, target[index:]...)...)
+			current = append(current, item.Id)
+			plan.itemIDs[item.Id] = item.Id
+			plan.videoIDs[item.Id] = itemVideoID(item)
+			plan.titles[item.Id] = itemTitle(item)
+		}
+	}
+	for _, insert := range plan.inserts {
+		current = append(current, insert.placeholder)
+	}
+	plan.moves = domain.PlanMoves(current, target)
+
+	return plan
+}
+
+func itemVideoID(item *youtube.PlaylistItem) string {
+	if item.Snippet == nil || item.Snippet.ResourceId == nil {
+		return ""
+	}
+	return item.Snippet.ResourceId.VideoId
+}
+
+func itemTitle(item *youtube.PlaylistItem) string {
+	if item.Snippet == nil {
+		return ""
+	}
+	return item.Snippet.Title
+}
+
+// movePlaylistItem leva o item à posição informada (a partir de 0).
+func (s *youtubeProvider) movePlaylistItem(playlistID, playlistItemID, videoID string, position int, ctx context.Context) error {
+	if err := s.spendQuota(ctx, quotaCostWrite); err != nil {
+		return domain.TagError(ctx, err)
+	}
+
+	update := &youtube.PlaylistItem{
+		Id: playlistItemID,
+		Snippet: &youtube.PlaylistItemSnippet{
+			PlaylistId: playlistID,
+			Position:   int64(position),
+			ResourceId: &youtube.ResourceId{
+				Kind:    "youtube#video",
+				VideoId: videoID,
+			},
+		},
+	}
+	// Position zero é o valor vazio do campo e sumiria do JSON sem ForceSendFields
+	update.Snippet.ForceSendFields = []string{"Position"}
+
+	callCtx, cancel := s.writeContext(ctx)
+	_, err := s.service.PlaylistItems.Update([]string{"snippet"}, update).Context(callCtx).Do()
+	cancel()
+	if err != nil {
+		return domain.TagError(ctx, fmt.Errorf("error in call youtube api: %w", err))
 	}
 
 	return nil
 }
 
-func (s *youtubeProvider) addVideoToPlaylist(playlistID, videoID string, ctx context.Context) error {
+func (s *youtubeProvider) DeletePlaylistItem(playlistItemID string, ctx context.Context) error {
+	if s.service == nil {
+		if err := s.getYoutubeService(ctx); err != nil {
+			return domain.TagError(ctx, fmt.Errorf("error while create youtube service: %w", err))
+		}
+	}
+
+	if playlistItemID == "" {
+		return domain.TagError(ctx, fmt.Errorf("playlist item id cannot be empty"))
+	}
+
+	if err := s.spendQuota(ctx, quotaCostWrite); err != nil {
+		return domain.TagError(ctx, err)
+	}
+
+	callCtx, cancel := s.writeContext(ctx)
+	err := s.service.PlaylistItems.Delete(playlistItemID).Context(callCtx).Do()
+	cancel()
+	if err != nil {
+		return domain.TagError(ctx, fmt.Errorf("error in call youtube api: %w", err))
+	}
+
+	return nil
+}
+
+// addVideoToPlaylist insere o vídeo no fim da playlist e devolve o ID do item criado.
+func (s *youtubeProvider) addVideoToPlaylist(playlistID, videoID string, ctx context.Context) (string, error) {
 	if s.service == nil {
 		err := s.getYoutubeService(ctx)
 		if err != nil {
-			return fmt.Errorf("error while create youtube service: %w", err)
+			return "", domain.TagError(ctx, fmt.Errorf("error while create youtube service: %w", err))
 		}
 	}
 
@@ -302,12 +651,18 @@ func (s *youtubeProvider) addVideoToPlaylist(playlistID, videoID string, ctx con
 
 	call := s.service.PlaylistItems.Insert([]string{"id", "snippet", "contentDetails"}, upload)
 
-	_, err := call.Do()
+	if err := s.spendQuota(ctx, quotaCostWrite); err != nil {
+		return "", domain.TagError(ctx, err)
+	}
+
+	callCtx, cancel := s.writeContext(ctx)
+	inserted, err := call.Context(callCtx).Do()
+	cancel()
 	if err != nil {
-		return fmt.Errorf("error in call youtube api: %w", err)
+		return "", domain.TagError(ctx, fmt.Errorf("error in call youtube api: %w", err))
 	}
 
-	return nil
+	return inserted.Id, nil
 }
 
 func (s *youtubeProvider) getPlaylistVideos(playlistID string, ctx context.Context) ([]domain.Video, error) {
@@ -317,8 +672,9 @@ func (s *youtubeProvider) getPlaylistVideos(playlistID string, ctx context.Conte
 	for {
 		returnedVideos, nextPageToken, err := s.enrich(playlistID, pageToken, ctx)
 		if err != nil {
-			return nil, fmt.Errorf("error while getting youtube videos: %w", err)
+			return nil, domain.TagError(ctx, fmt.Errorf("error while getting youtube videos: %w", err))
 		}
+		s.log.TraceContext(ctx, "Playlist page fetched", "playlist_id", playlistID, "videos", len(returnedVideos))
 
 		videos = append(videos, returnedVideos...)
 
@@ -330,7 +686,7 @@ func (s *youtubeProvider) getPlaylistVideos(playlistID string, ctx context.Conte
 	}
 
 	if len(videos) == 0 {
-		return nil, fmt.Errorf("no youtube video found for playlist %s", playlistID)
+		return nil, domain.TagError(ctx, fmt.Errorf("no youtube video found for playlist %s", playlistID))
 	}
 
 	return videos, nil
@@ -340,17 +696,23 @@ func (s *youtubeProvider) enrich(playlistID, pageToken string, ctx context.Conte
 	if s.service == nil {
 		err := s.getYoutubeService(ctx)
 		if err != nil {
-			return []domain.Video{}, "", fmt.Errorf("error while create youtube service: %w", err)
+			return []domain.Video{}, "", domain.TagError(ctx, fmt.Errorf("error while create youtube service: %w", err))
 		}
 	}
 
 	//preparando a chamada a api, onde devera retornar os itens da playlist baseado no "id" da playlist a pesquisa usa o pageToken para paginação
 	call := s.service.PlaylistItems.List([]string{"id", "contentDetails"}).PlaylistId(playlistID).PageToken(pageToken)
 
+	if err := s.spendQuota(ctx, quotaCostRead); err != nil {
+		return []domain.Video{}, "", domain.TagError(ctx, err)
+	}
+
 	//realizando a chamada
-	response, err := call.Do()
+	callCtx, cancel := s.callContext(ctx)
+	response, err := call.Context(callCtx).Do()
+	cancel()
 	if err != nil {
-		return []domain.Video{}, "", fmt.Errorf("error in call youtube api: %w", err)
+		return []domain.Video{}, "", domain.TagError(ctx, fmt.Errorf("error in call youtube api: %w", err))
 	}
 
 	// Cria um slice vazio com capacidade baseada na quantidade de itens retornados
@@ -363,10 +725,15 @@ func (s *youtubeProvider) enrich(playlistID, pageToken string, ctx context.Conte
 		}
 
 		video, err := s.getVideoDetails(item.ContentDetails.VideoId, ctx)
+		if errors.Is(err, errQuotaExceeded) {
+			return []domain.Video{}, "", domain.TagError(ctx, err)
+		}
 		if err != nil {
 			continue
 		}
 
+		video.ItemID = item.Id
+
 		videos = append(videos, video)
 	}
 
@@ -374,34 +741,44 @@ func (s *youtubeProvider) enrich(playlistID, pageToken string, ctx context.Conte
 }
 
 func (s *youtubeProvider) getVideoDetails(videoID string, ctx context.Context) (domain.Video, error) {
+	if video, ok := s.cache.get(videoID); ok {
+		s.log.TraceContext(ctx, "Video served from cache", "video_id", videoID)
+		return video, nil
+	}
+
 	if s.service == nil {
 		err := s.getYoutubeService(ctx)
 		if err != nil {
-			return domain.Video{}, fmt.Errorf("error while create youtube service: %w", err)
+			return domain.Video{}, domain.TagError(ctx, fmt.Errorf("error while create youtube service: %w", err))
 		}
 	}
 
 	call := s.service.Videos.List([]string{"snippet", "contentDetails"}).Id(videoID)
-	response, err := call.Do()
+	if err := s.spendQuota(ctx, quotaCostRead); err != nil {
+		return domain.Video{}, domain.TagError(ctx, err)
+	}
+	callCtx, cancel := s.callContext(ctx)
+	response, err := call.Context(callCtx).Do()
+	cancel()
 
 	if err != nil {
-		return domain.Video{}, fmt.Errorf("error while getting tack info: %w", err)
+		return domain.Video{}, domain.TagError(ctx, fmt.Errorf("error while getting tack info: %w", err))
 	}
 
 	if len(response.Items) == 0 {
-		return domain.Video{}, fmt.Errorf("video not found")
+		return domain.Video{}, domain.TagError(ctx, fmt.Errorf("video not found"))
 	}
 
 	item := response.Items[0]
 
 	parseDuration, err := duration.Parse(item.ContentDetails.Duration)
 	if err != nil {
-		return domain.Video{}, fmt.Errorf("error while parsing video duration: %w", err)
+		return domain.Video{}, domain.TagError(ctx, fmt.Errorf("error while parsing video duration: %w", err))
 	}
 
 	parsePublish, err := time.Parse(time.RFC3339, item.Snippet.PublishedAt)
 	if err != nil {
-		return domain.Video{}, fmt.Errorf("error while parsing video published: %w", err)
+		return domain.Video{}, domain.TagError(ctx, fmt.Errorf("error while parsing video published: %w", err))
 	}
 
 	video := domain.Video{
@@ -413,5 +790,38 @@ func (s *youtubeProvider) getVideoDetails(videoID string, ctx context.Context) (
 		Language:    item.Snippet.DefaultAudioLanguage,
 	}
 
+	s.cache.put(video)
+
 	return video, nil
 }
+
+func (s *youtubeProvider) GetMyChannel(ctx context.Context) (domain.Channel, error) {
+	if s.service == nil {
+		if err := s.getYoutubeService(ctx); err != nil {
+			return domain.Channel{}, domain.TagError(ctx, fmt.Errorf("error while create youtube service: %w", err))
+		}
+	}
+
+	if err := s.spendQuota(ctx, quotaCostRead); err != nil {
+		return domain.Channel{}, domain.TagError(ctx, err)
+	}
+
+	callCtx, cancel := s.callContext(ctx)
+	response, err := s.service.Channels.List([]string{"id", "snippet"}).Mine(true).Context(callCtx).Do()
+	cancel()
+	if err != nil {
+		return domain.Channel{}, domain.TagError(ctx, fmt.Errorf("error in call youtube api: %w", err))
+	}
+
+	if len(response.Items) == 0 {
+		return domain.Channel{}, domain.TagError(ctx, fmt.Errorf("no channel found for the authenticated account"))
+	}
+
+	item := response.Items[0]
+
+	return domain.Channel{
+		ID:        item.Id,
+		Title:     item.Snippet.Title,
+		CustomURL: item.Snippet.CustomUrl,
+	}, nil
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"TUI_playlist_reorder/internal/core/domain"
+
+	"google.golang.org/api/youtube/v3"
+)
+
+// playlistItems monta os itens da playlist no YouTube; o item de cada vídeo tem o ID "item-<vídeo>".
+func playlistItems(videoIDs ...string) []*youtube.PlaylistItem {
+	items := make([]*youtube.PlaylistItem, 0, len(videoIDs))
+	for _, id := range videoIDs {
+		items = append(items, &youtube.PlaylistItem{
+			Id:      "item-" + id,
+			Snippet: &youtube.PlaylistItemSnippet{Title: id, ResourceId: &youtube.ResourceId{VideoId: id}},
+		})
+	}
+	return items
+}
+
+func videoList(videoIDs ...string) []domain.Video {
+	videos := make([]domain.Video, 0, len(videoIDs))
+	for _, id := range videoIDs {
+		videos = append(videos, domain.Video{ID: id, ItemID: "item-" + id, Title: id})
+	}
+	return videos
+}
+
+// applyPlan executa o plano sobre os itens como a API faria e devolve os vídeos na ordem final.
+func applyPlan(items []*youtube.PlaylistItem, plan replacePlan) []string {
+	deleted := make(map[string]bool)
+	for _, item := range plan.deletes {
+		deleted[item.Id] = true
+	}
+	var order []string
+	for _, item := range items {
+		if !deleted[item.Id] {
+			order = append(order, item.Id)
+		}
+	}
+	for _, insert := range plan.inserts {
+		order = append(order, insert.placeholder)
+	}
+	for _, move := range plan.moves {
+		for i, id := range order {
+			if id == move.ItemID {
+				order = append(order[:i], order[i+1:]...)
+				break
+			}
+		}
+		order = append(order[:move.Position], append([]string{move.ItemID}, order[move.Position:]...)...)
+	}
+
+	videos := make([]string, 0, len(order))
+	for _, key := range order {
+		videos = append(videos, plan.videoIDs[key])
+	}
+	return videos
+}
+
+func TestPlanReplace(t *testing.T) {
+	removeAll := func(*youtube.PlaylistItem) bool { return true }
+	removeOnly := func(ids ...string) func(*youtube.PlaylistItem) bool {
+		return func(item *youtube.PlaylistItem) bool {
+			for _, id := range ids {
+				if item.Id == "item-"+id {
+					return true
+				}
+			}
+			return false
+		}
+	}
+
+	tests := []struct {
+		name    string
+		items   []string
+		videos  []string
+		remove  func(*youtube.PlaylistItem) bool
+		want    []string
+		deletes int
+		inserts int
+	}{
+		{
+			name:   "só reordena",
+			items:  []string{"a", "b", "c"},
+			videos: []string{"c", "a", "b"},
+			remove: removeOnly(),
+			want:   []string{"c", "a", "b"},
+		},
+		{
+			name:   "vídeos que a leitura pulou ficam no lugar",
+			items:  []string{"a", "privado", "b", "c", "novo"},
+			videos: []string{"c", "b", "a"},
+			remove: removeOnly(),
+			want:   []string{"c", "privado", "b", "a", "novo"},
+		},
+		{
+			name:    "remove só os itens pedidos",
+			items:   []string{"a", "privado", "b", "c"},
+			videos:  []string{"c", "a"},
+			remove:  removeOnly("b"),
+			want:    []string{"c", "privado", "a"},
+			deletes: 1,
+		},
+		{
+			name:    "reconstrução remove tudo o que não está na lista",
+			items:   []string{"a", "privado", "b"},
+			videos:  []string{"b", "x"},
+			remove:  removeAll,
+			want:    []string{"b", "x"},
+			deletes: 2,
+			inserts: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items := playlistItems(tt.items...)
+			plan := planReplace(items, videoList(tt.videos...), tt.remove)
+
+			if len(plan.deletes) != tt.deletes || len(plan.inserts) != tt.inserts {
+				t.Errorf("remoções = %d, inserções = %d; esperado %d e %d", len(plan.deletes), len(plan.inserts), tt.deletes, tt.inserts)
+			}
+			if got := applyPlan(items, plan); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ordem final = %v, esperado %v", got, tt.want)
+			}
+		})
+	}
+}
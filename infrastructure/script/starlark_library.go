@@ -0,0 +1,252 @@
+package script
+
+import (
+	"TUI_playlist_reorder/internal/core/domain"
+	"TUI_playlist_reorder/internal/core/ports"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+	"go.starlark.net/syntax"
+)
+
+const (
+	scriptExtension = ".star"
+	// limite de passos para que um script com laço infinito não trave a aplicação
+	maxExecutionSteps = 10_000_000
+)
+
+// StarlarkLibrary executa scripts Starlark de um diretório local. Cada script pode definir:
+//
+//	description = "texto exibido no menu"   (opcional)
+//	reverse = True                          (opcional, inverte a ordenação)
+//	def key(video): return ...              (chave de ordenação)
+//	def filter(video): return True/False    (filtro)
+//
+// video tem os campos id, title, artist, language, duration (segundos) e published (RFC3339).
+type StarlarkLibrary struct {
+	dir string
+	log ports.LoggerPort
+}
+
+func NewStarlarkLibrary(dir string, logger ports.LoggerPort) *StarlarkLibrary {
+	if dir == "" {
+		dir = "scripts"
+	}
+
+	return &StarlarkLibrary{dir: dir, log: logger}
+}
+
+type loadedScript struct {
+	globals starlark.StringDict
+	thread  *starlark.Thread
+}
+
+func (l *StarlarkLibrary) load(ctx context.Context, name string) (*loadedScript, error) {
+	path := filepath.Join(l.dir, name+scriptExtension)
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("não foi possível ler o script %s: %w", path, err)
+	}
+
+	thread := &starlark.Thread{
+		Name: "script:" + name,
+		Print: func(_ *starlark.Thread, msg string) {
+			l.log.InfoContext(ctx, msg, "script", name)
+		},
+	}
+	thread.SetMaxExecutionSteps(maxExecutionSteps)
+
+	stop := context.AfterFunc(ctx, func() { thread.Cancel(ctx.Err().Error()) })
+	defer stop()
+
+	globals, err := starlark.ExecFileOptions(&syntax.FileOptions{}, thread, path, src, nil)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao executar o script %s: %w", name, err)
+	}
+
+	return &loadedScript{globals: globals, thread: thread}, nil
+}
+
+func (s *loadedScript) function(name string) (*starlark.Function, bool) {
+	fn, ok := s.globals[name].(*starlark.Function)
+	return fn, ok
+}
+
+func (l *StarlarkLibrary) ListScripts() ([]domain.Script, error) {
+	entries, err := os.ReadDir(l.dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("falha ao listar scripts em '%s': %w", l.dir, err)
+	}
+
+	var scripts []domain.Script
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != scriptExtension {
+			continue
+		}
+
+		name := strings.TrimSuffix(entry.Name(), scriptExtension)
+		loaded, err := l.load(context.Background(), name)
+		if err != nil {
+			l.log.Error("Script ignorado", err, "script", name)
+			continue
+		}
+
+		script := domain.Script{Name: name}
+		if description, ok := loaded.globals["description"].(starlark.String); ok {
+			script.Description = string(description)
+		}
+		_, script.CanSort = loaded.function("key")
+		_, script.CanFilter = loaded.function("filter")
+
+		scripts = append(scripts, script)
+	}
+
+	sort.Slice(scripts, func(i, j int) bool {
+		return scripts[i].Name < scripts[j].Name
+	})
+
+	return scripts, nil
+}
+
+// Sorters expõe os scripts que definem key(video) como critérios de ordenação.
+func (l *StarlarkLibrary) Sorters() ([]ports.SorterPort, error) {
+	scripts, err := l.ListScripts()
+	if err != nil {
+		return nil, err
+	}
+
+	var sorters []ports.SorterPort
+	for _, script := range scripts {
+		if script.CanSort {
+			sorters = append(sorters, &scriptSorter{library: l, script: script})
+		}
+	}
+
+	return sorters, nil
+}
+
+func videoToStarlark(video domain.Video) starlark.Value {
+	return starlarkstruct.FromStringDict(starlarkstruct.Default, starlark.StringDict{
+		"id":        starlark.String(video.ID),
+		"title":     starlark.String(video.Title),
+		"artist":    starlark.String(video.Artist),
+		"language":  starlark.String(video.Language),
+		"duration":  starlark.MakeInt64(int64(video.Duration / time.Second)),
+		"published": starlark.String(video.PublishedAt.Format(time.RFC3339)),
+	})
+}
+
+func (l *StarlarkLibrary) FilterVideos(ctx context.Context, scriptName string, videos []domain.Video) ([]domain.Video, error) {
+	loaded, err := l.load(ctx, scriptName)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, ok := loaded.function("filter")
+	if !ok {
+		return nil, fmt.Errorf("script %s não define filter(video)", scriptName)
+	}
+
+	stop := context.AfterFunc(ctx, func() { loaded.thread.Cancel(ctx.Err().Error()) })
+	defer stop()
+
+	var matched []domain.Video
+	for _, video := range videos {
+		result, err := starlark.Call(loaded.thread, filter, starlark.Tuple{videoToStarlark(video)}, nil)
+		if err != nil {
+			return nil, fmt.Errorf("erro no filtro do script %s: %w", scriptName, err)
+		}
+
+		keep, ok := result.(starlark.Bool)
+		if !ok {
+			return nil, fmt.Errorf("filter(video) do script %s deve retornar bool, retornou %s", scriptName, result.Type())
+		}
+		if keep {
+			matched = append(matched, video)
+		}
+	}
+
+	return matched, nil
+}
+
+type scriptSorter struct {
+	library *StarlarkLibrary
+	script  domain.Script
+}
+
+func (s *scriptSorter) Name() string {
+	return "script:" + s.script.Name
+}
+
+func (s *scriptSorter) Label() string {
+	if s.script.Description != "" {
+		return fmt.Sprintf("Script: %s", s.script.Description)
+	}
+	return fmt.Sprintf("Ordenar via script: %s", s.script.Name)
+}
+
+func (s *scriptSorter) Sort(ctx context.Context, playlist domain.Playlist) (domain.Playlist, error) {
+	loaded, err := s.library.load(ctx, s.script.Name)
+	if err != nil {
+		return domain.Playlist{}, err
+	}
+
+	keyFn, ok := loaded.function("key")
+	if !ok {
+		return domain.Playlist{}, fmt.Errorf("script %s não define key(video)", s.script.Name)
+	}
+
+	stop := context.AfterFunc(ctx, func() { loaded.thread.Cancel(ctx.Err().Error()) })
+	defer stop()
+
+	keys := make([]starlark.Value, len(playlist.Videos))
+	for i, video := range playlist.Videos {
+		key, err := starlark.Call(loaded.thread, keyFn, starlark.Tuple{videoToStarlark(video)}, nil)
+		if err != nil {
+			return domain.Playlist{}, fmt.Errorf("erro na chave do script %s: %w", s.script.Name, err)
+		}
+		keys[i] = key
+	}
+
+	reverse := bool(loaded.globals["reverse"] == starlark.True)
+
+	indexes := make([]int, len(playlist.Videos))
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	var compareErr error
+	sort.SliceStable(indexes, func(i, j int) bool {
+		a, b := keys[indexes[i]], keys[indexes[j]]
+		if reverse {
+			a, b = b, a
+		}
+		less, err := starlark.Compare(syntax.LT, a, b)
+		if err != nil && compareErr == nil {
+			compareErr = err
+		}
+		return less
+	})
+
+	if compareErr != nil {
+		return domain.Playlist{}, fmt.Errorf("chaves do script %s não são comparáveis: %w", s.script.Name, compareErr)
+	}
+
+	sorted := playlist
+	sorted.Videos = make([]domain.Video, 0, len(playlist.Videos))
+	for _, i := range indexes {
+		sorted.Videos = append(sorted.Videos, playlist.Videos[i])
+	}
+
+	return sorted, nil
+}
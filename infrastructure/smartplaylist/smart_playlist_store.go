@@ -0,0 +1,133 @@
+package smartplaylist
+
+import (
+	"TUI_playlist_reorder/internal/core/domain"
+	"TUI_playlist_reorder/internal/core/ports"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+type smartPlaylistStore struct {
+	filePath string
+	mu       sync.Mutex
+}
+
+// NewSmartPlaylistStore guarda todas as definições em um único arquivo JSON.
+func NewSmartPlaylistStore(filePath string) ports.SmartPlaylistPort {
+	if filePath == "" {
+		filePath = "smart_playlists.json"
+	}
+
+	return &smartPlaylistStore{filePath: filePath}
+}
+
+func (s *smartPlaylistStore) readAll() (map[string]domain.SmartPlaylist, error) {
+	definitions := make(map[string]domain.SmartPlaylist)
+
+	file, err := os.Open(s.filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return definitions, nil
+		}
+		return nil, fmt.Errorf("falha ao abrir arquivo de smart playlists %s: %w", s.filePath, err)
+	}
+
+	defer file.Close()
+
+	if err := json.NewDecoder(file).Decode(&definitions); err != nil {
+		return nil, fmt.Errorf("falha ao decodificar smart playlists do arquivo %s: %w", s.filePath, err)
+	}
+
+	return definitions, nil
+}
+
+func (s *smartPlaylistStore) writeAll(definitions map[string]domain.SmartPlaylist) error {
+	if dir := filepath.Dir(s.filePath); dir != "" {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("falha ao criar o diretório '%s': %w", dir, err)
+		}
+	}
+
+	file, err := os.OpenFile(s.filePath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return fmt.Errorf("não foi possível abrir/criar o arquivo de smart playlists %s: %w", s.filePath, err)
+	}
+
+	defer file.Close()
+
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(definitions)
+}
+
+func (s *smartPlaylistStore) SaveSmartPlaylist(definition domain.SmartPlaylist) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	definitions, err := s.readAll()
+	if err != nil {
+		return err
+	}
+
+	definitions[definition.Name] = definition
+	return s.writeAll(definitions)
+}
+
+func (s *smartPlaylistStore) ListSmartPlaylists() ([]domain.SmartPlaylist, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	definitions, err := s.readAll()
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]domain.SmartPlaylist, 0, len(definitions))
+	for _, definition := range definitions {
+		list = append(list, definition)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+
+	return list, nil
+}
+
+func (s *smartPlaylistStore) LoadSmartPlaylist(name string) (domain.SmartPlaylist, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	definitions, err := s.readAll()
+	if err != nil {
+		return domain.SmartPlaylist{}, err
+	}
+
+	definition, ok := definitions[name]
+	if !ok {
+		return domain.SmartPlaylist{}, fmt.Errorf("smart playlist '%s' não encontrada", name)
+	}
+
+	return definition, nil
+}
+
+func (s *smartPlaylistStore) DeleteSmartPlaylist(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	definitions, err := s.readAll()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := definitions[name]; !ok {
+		return fmt.Errorf("smart playlist '%s' não encontrada", name)
+	}
+
+	delete(definitions, name)
+	return s.writeAll(definitions)
+}
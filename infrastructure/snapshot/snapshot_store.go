@@ -0,0 +1,130 @@
+package snapshot
+
+import (
+	"TUI_playlist_reorder/internal/core/domain"
+	"TUI_playlist_reorder/internal/core/ports"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const snapshotIDLayout = "20060102T150405.000000000"
+
+type snapshotStore struct {
+	dir string
+}
+
+// NewSnapshotStore grava cada snapshot em <dir>/<playlistID>/<snapshotID>.json
+func NewSnapshotStore(dir string) ports.SnapshotPort {
+	if dir == "" {
+		dir = "snapshots"
+	}
+
+	return &snapshotStore{dir: dir}
+}
+
+func (s *snapshotStore) SaveSnapshot(playlist domain.Playlist) (domain.Snapshot, error) {
+	if playlist.ID == "" {
+		return domain.Snapshot{}, fmt.Errorf("playlist sem ID não pode ser salva como snapshot")
+	}
+	if err := checkPathSegment("playlist", playlist.ID); err != nil {
+		return domain.Snapshot{}, err
+	}
+
+	playlistDir := filepath.Join(s.dir, playlist.ID)
+	if err := os.MkdirAll(playlistDir, 0755); err != nil {
+		return domain.Snapshot{}, fmt.Errorf("falha ao criar o diretório de snapshots '%s': %w", playlistDir, err)
+	}
+
+	takenAt := time.Now().UTC()
+	snapshot := domain.Snapshot{
+		ID:       takenAt.Format(snapshotIDLayout),
+		TakenAt:  takenAt,
+		Playlist: playlist,
+	}
+
+	path := filepath.Join(playlistDir, snapshot.ID+".json")
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return domain.Snapshot{}, fmt.Errorf("não foi possível criar o arquivo de snapshot %s: %w", path, err)
+	}
+
+	defer file.Close()
+
+	if err := json.NewEncoder(file).Encode(snapshot); err != nil {
+		return domain.Snapshot{}, fmt.Errorf("falha ao gravar snapshot %s: %w", path, err)
+	}
+
+	return snapshot, nil
+}
+
+// ListSnapshots retorna os snapshots da playlist do mais recente para o mais antigo.
+func (s *snapshotStore) ListSnapshots(playlistID string) ([]domain.Snapshot, error) {
+	if err := checkPathSegment("playlist", playlistID); err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(filepath.Join(s.dir, playlistID))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("falha ao listar snapshots da playlist %s: %w", playlistID, err)
+	}
+
+	var snapshots []domain.Snapshot
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+
+		snapshot, err := s.LoadSnapshot(playlistID, strings.TrimSuffix(entry.Name(), ".json"))
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].TakenAt.After(snapshots[j].TakenAt)
+	})
+
+	return snapshots, nil
+}
+
+func (s *snapshotStore) LoadSnapshot(playlistID, snapshotID string) (domain.Snapshot, error) {
+	if err := checkPathSegment("playlist", playlistID); err != nil {
+		return domain.Snapshot{}, err
+	}
+	if err := checkPathSegment("snapshot", snapshotID); err != nil {
+		return domain.Snapshot{}, err
+	}
+
+	path := filepath.Join(s.dir, playlistID, snapshotID+".json")
+	file, err := os.Open(path)
+	if err != nil {
+		return domain.Snapshot{}, fmt.Errorf("falha ao abrir snapshot %s: %w", path, err)
+	}
+
+	defer file.Close()
+
+	var snapshot domain.Snapshot
+	if err := json.NewDecoder(file).Decode(&snapshot); err != nil {
+		return domain.Snapshot{}, fmt.Errorf("falha ao decodificar snapshot %s: %w", path, err)
+	}
+
+	return snapshot, nil
+}
+
+// checkPathSegment recusa IDs que, usados como nome de arquivo ou diretório, sairiam do
+// diretório de snapshots (ex.: "../../token" vindo da linha de comando).
+func checkPathSegment(kind, id string) error {
+	if id == "" || id == "." || strings.Contains(id, "..") || strings.ContainsAny(id, `/\`) {
+		return fmt.Errorf("ID de %s inválido: %q", kind, id)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package snapshot
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"TUI_playlist_reorder/internal/core/domain"
+)
+
+func TestLoadSnapshotRejectsPathsOutsideTheStore(t *testing.T) {
+	root := t.TempDir()
+	store := NewSnapshotStore(filepath.Join(root, "snapshots"))
+
+	// arquivo fora do diretório de snapshots que um ID malicioso tentaria ler
+	if err := os.WriteFile(filepath.Join(root, "token.json"), []byte(`{"ID":"segredo"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	saved, err := store.SaveSnapshot(domain.Playlist{ID: "PL1", Title: "Teste"})
+	if err != nil {
+		t.Fatalf("SaveSnapshot() erro = %v", err)
+	}
+	if loaded, err := store.LoadSnapshot("PL1", saved.ID); err != nil || loaded.ID != saved.ID {
+		t.Fatalf("LoadSnapshot() = %+v, %v", loaded, err)
+	}
+
+	tests := []struct {
+		name       string
+		playlistID string
+		snapshotID string
+	}{
+		{"snapshot subindo diretórios", "PL1", "../../token"},
+		{"snapshot com barra", "PL1", "sub/arquivo"},
+		{"snapshot com barra invertida", "PL1", `..\token`},
+		{"snapshot vazio", "PL1", ""},
+		{"playlist subindo diretórios", "..", "token"},
+		{"playlist com barra", "PL1/..", saved.ID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := store.LoadSnapshot(tt.playlistID, tt.snapshotID); err == nil {
+				t.Errorf("LoadSnapshot(%q, %q) aceitou um ID inválido", tt.playlistID, tt.snapshotID)
+			}
+		})
+	}
+
+	if _, err := store.ListSnapshots("../snapshots"); err == nil {
+		t.Error("ListSnapshots() aceitou um ID de playlist inválido")
+	}
+}
@@ -0,0 +1,135 @@
+package sorter
+
+import (
+	"TUI_playlist_reorder/internal/core/domain"
+	"TUI_playlist_reorder/internal/core/ports"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const pluginTimeout = 30 * time.Second
+
+// pluginVideo e pluginPlaylist formam o JSON enviado ao stdin do plugin.
+type pluginVideo struct {
+	ID              string `json:"id"`
+	Title           string `json:"title"`
+	Artist          string `json:"artist"`
+	PublishedAt     string `json:"published_at"`
+	DurationSeconds int64  `json:"duration_seconds"`
+	Language        string `json:"language"`
+}
+
+type pluginPlaylist struct {
+	ID     string        `json:"id"`
+	Title  string        `json:"title"`
+	Videos []pluginVideo `json:"videos"`
+}
+
+type pluginSorter struct {
+	name string
+	path string
+	log  ports.LoggerPort
+}
+
+// DiscoverPlugins retorna um sorter para cada executável encontrado em dir.
+// Um diretório inexistente não é erro: apenas não há plugins.
+func DiscoverPlugins(dir string, logger ports.LoggerPort) ([]ports.SorterPort, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("falha ao listar plugins em '%s': %w", dir, err)
+	}
+
+	var sorters []ports.SorterPort
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil || info.Mode().Perm()&0111 == 0 {
+			continue
+		}
+
+		name := strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name()))
+		sorters = append(sorters, &pluginSorter{
+			name: name,
+			path: filepath.Join(dir, entry.Name()),
+			log:  logger,
+		})
+		logger.Info("Plugin de ordenação encontrado", "plugin", entry.Name())
+	}
+
+	sort.Slice(sorters, func(i, j int) bool {
+		return sorters[i].Name() < sorters[j].Name()
+	})
+
+	return sorters, nil
+}
+
+func (s *pluginSorter) Name() string {
+	return "plugin:" + s.name
+}
+
+func (s *pluginSorter) Label() string {
+	return fmt.Sprintf("Ordenar via plugin: %s", s.name)
+}
+
+// Sort envia a playlist em JSON para o stdin do plugin e espera no stdout um array
+// JSON com os IDs dos vídeos na nova ordem.
+func (s *pluginSorter) Sort(ctx context.Context, playlist domain.Playlist) (domain.Playlist, error) {
+	input := pluginPlaylist{ID: playlist.ID, Title: playlist.Title, Videos: make([]pluginVideo, 0, len(playlist.Videos))}
+	for _, video := range playlist.Videos {
+		input.Videos = append(input.Videos, pluginVideo{
+			ID:              video.ID,
+			Title:           video.Title,
+			Artist:          video.Artist,
+			PublishedAt:     video.PublishedAt.Format(time.RFC3339),
+			DurationSeconds: int64(video.Duration.Seconds()),
+			Language:        video.Language,
+		})
+	}
+
+	payload, err := json.Marshal(input)
+	if err != nil {
+		return domain.Playlist{}, fmt.Errorf("falha ao serializar playlist para o plugin: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, pluginTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, s.path)
+	cmd.Stdin = bytes.NewReader(payload)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	s.log.InfoContext(ctx, "Executando plugin", "plugin", s.path, "playlist_id", playlist.ID, "videos", len(playlist.Videos))
+
+	if err := cmd.Run(); err != nil {
+		return domain.Playlist{}, fmt.Errorf("plugin %s falhou: %w (stderr: %s)", s.name, err, strings.TrimSpace(stderr.String()))
+	}
+
+	var order []string
+	if err := json.Unmarshal(stdout.Bytes(), &order); err != nil {
+		return domain.Playlist{}, fmt.Errorf("saída inválida do plugin %s, esperado um array JSON de IDs: %w", s.name, err)
+	}
+
+	sorted := playlist
+	sorted.Videos = append([]domain.Video(nil), playlist.Videos...)
+	if err := sorted.ApplyOrder(order); err != nil {
+		return domain.Playlist{}, fmt.Errorf("ordem devolvida pelo plugin %s não é uma permutação da playlist: %w", s.name, err)
+	}
+
+	return sorted, nil
+}
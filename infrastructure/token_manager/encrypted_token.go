@@ -0,0 +1,239 @@
+package token_manager
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/oauth2"
+)
+
+// Parâmetros do Argon2id (recomendação da RFC 9106 para ambientes com pouca memória)
+const (
+	argonTime    = 3
+	argonMemory  = 64 * 1024 // KiB
+	argonThreads = 4
+	argonKeyLen  = 32 // AES-256
+	saltLen      = 16
+)
+
+// ErrWrongPassphrase indica que o token não pôde ser decifrado com a senha informada.
+var ErrWrongPassphrase = errors.New("senha incorreta ou arquivo de token corrompido")
+
+// encryptedTokenFile é o formato em disco: o token serializado em JSON e cifrado com
+// AES-GCM usando uma chave derivada da senha via Argon2id.
+type encryptedTokenFile struct {
+	Version    int    `json:"version"`
+	KDF        string `json:"kdf"`
+	Time       uint32 `json:"time"`
+	Memory     uint32 `json:"memory"`
+	Threads    uint8  `json:"threads"`
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+type encryptedTokenServiceImpl struct {
+	TokenFilePath string
+	passphrase    PassphraseSource
+}
+
+// NewEncryptedTokenService cria um TokenService que cifra o token em disco. A senha só é
+// pedida a passphrase na primeira leitura ou escrita.
+func NewEncryptedTokenService(tokenFilePath string, passphrase PassphraseSource) TokenService {
+	if tokenFilePath == "" {
+		tokenFilePath = "token.json"
+	}
+
+	return &encryptedTokenServiceImpl{
+		TokenFilePath: tokenFilePath,
+		passphrase:    passphrase,
+	}
+}
+
+func (t *encryptedTokenServiceImpl) DeleteLocalToken() error {
+	err := os.Remove(t.TokenFilePath)
+	if err != nil {
+		return fmt.Errorf("não foi possível remover o arquivo de token: %w", err)
+	}
+
+	return nil
+}
+
+func (t *encryptedTokenServiceImpl) LoadToken() (*oauth2.Token, error) {
+	data, err := os.ReadFile(t.TokenFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao abrir arquivo de token %s: %w", t.TokenFilePath, err)
+	}
+
+	var file encryptedTokenFile
+	if err := json.Unmarshal(data, &file); err != nil || !isEncrypted(file) {
+		return nil, fmt.Errorf("o arquivo de token %s não está cifrado; rode o comando migrate-tokens", t.TokenFilePath)
+	}
+
+	passphrase, err := t.passphrase()
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := decrypt(file, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao decifrar o token do arquivo %s: %w", t.TokenFilePath, err)
+	}
+
+	token, err := unmarshalToken(plaintext)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao decodificar token do arquivo %s: %w", t.TokenFilePath, err)
+	}
+
+	if token.AccessToken == "" && token.RefreshToken == "" {
+		return nil, fmt.Errorf("token inválido: não contém AccessToken ou RefreshToken")
+	}
+
+	return token, nil
+}
+
+func (t *encryptedTokenServiceImpl) SaveToken(token *oauth2.Token) error {
+	passphrase, err := t.passphrase()
+	if err != nil {
+		return err
+	}
+
+	plaintext, err := marshalToken(token)
+	if err != nil {
+		return fmt.Errorf("falha ao serializar o token: %w", err)
+	}
+
+	return writeEncrypted(t.TokenFilePath, plaintext, passphrase)
+}
+
+// EncryptTokenFile converte um arquivo de token em texto puro para o formato cifrado.
+// Retorna false, sem erro, se o arquivo não existir ou já estiver cifrado.
+func EncryptTokenFile(path, passphrase string) (bool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("falha ao ler o arquivo de token %s: %w", path, err)
+	}
+
+	var file encryptedTokenFile
+	if err := json.Unmarshal(data, &file); err == nil && isEncrypted(file) {
+		return false, nil
+	}
+
+	// garante que é um token válido antes de cifrar
+	token, err := unmarshalToken(data)
+	if err != nil {
+		return false, fmt.Errorf("falha ao decodificar token do arquivo %s: %w", path, err)
+	}
+	if token.AccessToken == "" && token.RefreshToken == "" {
+		return false, fmt.Errorf("token inválido em %s: não contém AccessToken ou RefreshToken", path)
+	}
+
+	plaintext, err := marshalToken(token)
+	if err != nil {
+		return false, fmt.Errorf("falha ao serializar o token: %w", err)
+	}
+
+	if err := writeEncrypted(path, plaintext, passphrase); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func isEncrypted(file encryptedTokenFile) bool {
+	return file.Version > 0 && len(file.Ciphertext) > 0
+}
+
+func deriveKey(passphrase string, salt []byte, time, memory uint32, threads uint8) []byte {
+	return argon2.IDKey([]byte(passphrase), salt, time, memory, threads, argonKeyLen)
+}
+
+func decrypt(file encryptedTokenFile, passphrase string) ([]byte, error) {
+	if file.KDF != "argon2id" {
+		return nil, fmt.Errorf("KDF não suportada: %s", file.KDF)
+	}
+
+	key := deriveKey(passphrase, file.Salt, file.Time, file.Memory, file.Threads)
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := gcm.Open(nil, file.Nonce, file.Ciphertext, nil)
+	if err != nil {
+		return nil, ErrWrongPassphrase
+	}
+
+	return plaintext, nil
+}
+
+// writeEncrypted grava em um arquivo temporário e renomeia, para nunca deixar um token pela metade.
+func writeEncrypted(path string, plaintext []byte, passphrase string) error {
+	salt := make([]byte, saltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return fmt.Errorf("falha ao gerar salt: %w", err)
+	}
+
+	key := deriveKey(passphrase, salt, argonTime, argonMemory, argonThreads)
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return fmt.Errorf("falha ao criar a cifra: %w", err)
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return fmt.Errorf("falha ao criar a cifra: %w", err)
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return fmt.Errorf("falha ao gerar nonce: %w", err)
+	}
+
+	data, err := json.Marshal(encryptedTokenFile{
+		Version:    1,
+		KDF:        "argon2id",
+		Time:       argonTime,
+		Memory:     argonMemory,
+		Threads:    argonThreads,
+		Salt:       salt,
+		Nonce:      nonce,
+		Ciphertext: gcm.Seal(nil, nonce, plaintext, nil),
+	})
+	if err != nil {
+		return fmt.Errorf("falha ao serializar o token cifrado: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("não foi possível criar o diretório do token %s: %w", path, err)
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return fmt.Errorf("não foi possível gravar o arquivo de token %s: %w", path, err)
+	}
+
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("não foi possível gravar o arquivo de token %s: %w", path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package token_manager
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"golang.org/x/term"
+)
+
+// PassphraseEnv é consultada antes de pedir a senha no terminal (útil em scripts e CI).
+const PassphraseEnv = "REORDER_PLAYLIST_TOKEN_PASSPHRASE"
+
+// PassphraseSource fornece a senha usada para derivar a chave do token cifrado.
+type PassphraseSource func() (string, error)
+
+// NewPassphraseSource lê a senha de PassphraseEnv ou, se ela não estiver definida, pede no
+// terminal. A senha é obtida uma única vez e reaproveitada por todos os perfis.
+// Com confirm a senha é pedida duas vezes (primeira gravação de um token).
+func NewPassphraseSource(out io.Writer, confirm bool) PassphraseSource {
+	var once sync.Once
+	var passphrase string
+	var err error
+
+	return func() (string, error) {
+		once.Do(func() {
+			if value, ok := os.LookupEnv(PassphraseEnv); ok && value != "" {
+				passphrase = value
+				return
+			}
+			passphrase, err = ReadPassphrase(out, confirm)
+		})
+		return passphrase, err
+	}
+}
+
+// ReadPassphrase pede a senha no terminal sem ecoar os caracteres.
+func ReadPassphrase(out io.Writer, confirm bool) (string, error) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return "", fmt.Errorf("senha do token necessária: defina %s ou execute em um terminal", PassphraseEnv)
+	}
+
+	fmt.Fprint(out, "Senha do token: ")
+	first, err := term.ReadPassword(fd)
+	fmt.Fprintln(out)
+	if err != nil {
+		return "", fmt.Errorf("falha ao ler a senha: %w", err)
+	}
+	if len(first) == 0 {
+		return "", fmt.Errorf("a senha do token não pode ser vazia")
+	}
+
+	if confirm {
+		fmt.Fprint(out, "Confirme a senha: ")
+		second, err := term.ReadPassword(fd)
+		fmt.Fprintln(out)
+		if err != nil {
+			return "", fmt.Errorf("falha ao ler a senha: %w", err)
+		}
+		if string(first) != string(second) {
+			return "", fmt.Errorf("as senhas não conferem")
+		}
+	}
+
+	return string(first), nil
+}
@@ -0,0 +1,105 @@
+package token_manager
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+)
+
+// DefaultProfile é o perfil que usa o arquivo de token original (token_file da configuração).
+const DefaultProfile = "default"
+
+var profileNamePattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]{0,63}$`)
+
+// ProfileStore organiza um token por perfil: o perfil padrão continua em defaultTokenFile
+// e os demais ficam em <dir do token>/profiles/<nome>/token.json.
+type ProfileStore interface {
+	List() ([]string, error)
+	TokenService(profile string) (TokenService, error)
+	TokenFile(profile string) (string, error)
+	Exists(profile string) bool
+}
+
+// TokenServiceFactory cria o TokenService do backend configurado (texto puro ou cifrado).
+type TokenServiceFactory func(tokenFilePath string) TokenService
+
+type profileStoreImpl struct {
+	defaultTokenFile string
+	profilesDir      string
+	newTokenService  TokenServiceFactory
+}
+
+func NewProfileStore(defaultTokenFile string, newTokenService TokenServiceFactory) ProfileStore {
+	if newTokenService == nil {
+		newTokenService = NewTokenService
+	}
+
+	return &profileStoreImpl{
+		defaultTokenFile: defaultTokenFile,
+		profilesDir:      filepath.Join(filepath.Dir(defaultTokenFile), "profiles"),
+		newTokenService:  newTokenService,
+	}
+}
+
+func ValidateProfileName(profile string) error {
+	if !profileNamePattern.MatchString(profile) {
+		return fmt.Errorf("nome de perfil inválido '%s': use letras, números, '-' ou '_'", profile)
+	}
+	return nil
+}
+
+func (p *profileStoreImpl) tokenFile(profile string) string {
+	if profile == DefaultProfile {
+		return p.defaultTokenFile
+	}
+	return filepath.Join(p.profilesDir, profile, "token.json")
+}
+
+func (p *profileStoreImpl) TokenService(profile string) (TokenService, error) {
+	if err := ValidateProfileName(profile); err != nil {
+		return nil, err
+	}
+	return p.newTokenService(p.tokenFile(profile)), nil
+}
+
+// TokenFile retorna o caminho do arquivo de token do perfil, exista ele ou não.
+func (p *profileStoreImpl) TokenFile(profile string) (string, error) {
+	if err := ValidateProfileName(profile); err != nil {
+		return "", err
+	}
+	return p.tokenFile(profile), nil
+}
+
+// Exists indica se o perfil já tem um token salvo.
+func (p *profileStoreImpl) Exists(profile string) bool {
+	if ValidateProfileName(profile) != nil {
+		return false
+	}
+	_, err := os.Stat(p.tokenFile(profile))
+	return err == nil
+}
+
+// List retorna o perfil padrão e todos os perfis com diretório criado, em ordem alfabética.
+func (p *profileStoreImpl) List() ([]string, error) {
+	profiles := []string{DefaultProfile}
+
+	entries, err := os.ReadDir(p.profilesDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return profiles, nil
+		}
+		return nil, fmt.Errorf("falha ao listar perfis em %s: %w", p.profilesDir, err)
+	}
+
+	var others []string
+	for _, entry := range entries {
+		if entry.IsDir() && entry.Name() != DefaultProfile && ValidateProfileName(entry.Name()) == nil {
+			others = append(others, entry.Name())
+		}
+	}
+	sort.Strings(others)
+
+	return append(profiles, others...), nil
+}
@@ -5,8 +5,39 @@ import (
 	"fmt"
 	"golang.org/x/oauth2"
 	"os"
+	"path/filepath"
 )
 
+// TokenClientIDKey é o campo extra do token (oauth2.Token.Extra) com o client_id do cliente
+// OAuth que o emitiu: a renovação precisa ser feita pelo mesmo cliente. Fica gravado junto
+// com o token.
+const TokenClientIDKey = "client_id"
+
+// storedToken é o token como fica em disco (dentro do arquivo cifrado, no outro backend).
+type storedToken struct {
+	oauth2.Token
+	ClientID string `json:"client_id,omitempty"`
+}
+
+func marshalToken(token *oauth2.Token) ([]byte, error) {
+	stored := storedToken{Token: *token}
+	stored.ClientID, _ = token.Extra(TokenClientIDKey).(string)
+	return json.Marshal(stored)
+}
+
+func unmarshalToken(data []byte) (*oauth2.Token, error) {
+	var stored storedToken
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, err
+	}
+
+	token := &stored.Token
+	if stored.ClientID != "" {
+		token = token.WithExtra(map[string]interface{}{TokenClientIDKey: stored.ClientID})
+	}
+	return token, nil
+}
+
 type tokenServiceImpl struct {
 	TokenFilePath string
 }
@@ -43,9 +74,18 @@ func (t *tokenServiceImpl) LoadToken() (*oauth2.Token, error) {
 	}
 
 	defer file.Close()
-	token := &oauth2.Token{}
 
-	err = json.NewDecoder(file).Decode(token)
+	var raw json.RawMessage
+	if err = json.NewDecoder(file).Decode(&raw); err != nil {
+		return nil, fmt.Errorf("falha ao decodificar token do arquivo %s: %w", t.TokenFilePath, err)
+	}
+
+	var encrypted encryptedTokenFile
+	if json.Unmarshal(raw, &encrypted) == nil && isEncrypted(encrypted) {
+		return nil, fmt.Errorf("o arquivo de token %s está cifrado; use token_backend = \"encrypted\"", t.TokenFilePath)
+	}
+
+	token, err := unmarshalToken(raw)
 	if err != nil {
 		return nil, fmt.Errorf("falha ao decodificar token do arquivo %s: %w", t.TokenFilePath, err)
 	}
@@ -58,11 +98,21 @@ func (t *tokenServiceImpl) LoadToken() (*oauth2.Token, error) {
 }
 
 func (t *tokenServiceImpl) SaveToken(token *oauth2.Token) error {
+	if err := os.MkdirAll(filepath.Dir(t.TokenFilePath), 0700); err != nil {
+		return fmt.Errorf("não foi possível criar o diretório do token %s: %w", t.TokenFilePath, err)
+	}
+
 	file, err := os.OpenFile(t.TokenFilePath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
 	if err != nil {
 		return fmt.Errorf("não foi possível abrir/criar o arquivo de token %s: %w", t.TokenFilePath, err)
 	}
 
 	defer file.Close()
-	return json.NewEncoder(file).Encode(token)
+
+	data, err := marshalToken(token)
+	if err != nil {
+		return fmt.Errorf("falha ao serializar o token: %w", err)
+	}
+	_, err = file.Write(append(data, '\n'))
+	return err
 }
@@ -0,0 +1,16 @@
+package domain
+
+// Channel é o canal do YouTube da conta autenticada.
+type Channel struct {
+	ID        string
+	Title     string
+	CustomURL string
+}
+
+// Profile é uma conta Google (ou canal de marca) com token próprio.
+type Profile struct {
+	Name     string
+	Active   bool
+	LoggedIn bool
+	Channel  *Channel // nil quando não há login ou o canal não pôde ser consultado
+}
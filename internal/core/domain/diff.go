@@ -0,0 +1,90 @@
+package domain
+
+import "time"
+
+type DiffEntry struct {
+	Video     Video
+	PositionA int
+	PositionB int
+	// posições relativas considerando apenas os vídeos presentes nas duas playlists,
+	// assim inserções e remoções não contam como mudança de ordem
+	RelativeA int
+	RelativeB int
+}
+
+// Moved indica quantas posições o vídeo andou em relação aos demais vídeos em comum
+// (positivo = desceu na lista, negativo = subiu).
+func (e DiffEntry) Moved() int {
+	return e.RelativeB - e.RelativeA
+}
+
+type PlaylistDiff struct {
+	A       Playlist
+	B       Playlist
+	OnlyInA []Video
+	OnlyInB []Video
+	InBoth  []DiffEntry
+}
+
+func (d PlaylistDiff) MovedCount() int {
+	count := 0
+	for _, entry := range d.InBoth {
+		if entry.Moved() != 0 {
+			count++
+		}
+	}
+	return count
+}
+
+type Snapshot struct {
+	ID       string
+	TakenAt  time.Time
+	Playlist Playlist
+}
+
+func DiffPlaylists(a, b Playlist) PlaylistDiff {
+	diff := PlaylistDiff{A: a, B: b}
+
+	positionsB := make(map[string]int, len(b.Videos))
+	for i, video := range b.Videos {
+		if _, seen := positionsB[video.ID]; !seen {
+			positionsB[video.ID] = i
+		}
+	}
+
+	positionsA := make(map[string]int, len(a.Videos))
+	for i, video := range a.Videos {
+		if _, seen := positionsA[video.ID]; seen {
+			continue
+		}
+		positionsA[video.ID] = i
+
+		if posB, ok := positionsB[video.ID]; ok {
+			diff.InBoth = append(diff.InBoth, DiffEntry{Video: video, PositionA: i, PositionB: posB})
+		} else {
+			diff.OnlyInA = append(diff.OnlyInA, video)
+		}
+	}
+
+	seenB := make(map[string]bool, len(b.Videos))
+	relativeB := make(map[string]int, len(diff.InBoth))
+	for _, video := range b.Videos {
+		if seenB[video.ID] {
+			continue
+		}
+		seenB[video.ID] = true
+
+		if _, inA := positionsA[video.ID]; inA {
+			relativeB[video.ID] = len(relativeB)
+		} else {
+			diff.OnlyInB = append(diff.OnlyInB, video)
+		}
+	}
+
+	for i := range diff.InBoth {
+		diff.InBoth[i].RelativeA = i
+		diff.InBoth[i].RelativeB = relativeB[diff.InBoth[i].Video.ID]
+	}
+
+	return diff
+}
@@ -0,0 +1,180 @@
+package domain
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+type DuplicateKind int
+
+const (
+	DuplicateExact DuplicateKind = iota
+	DuplicateNear
+)
+
+// DuplicateGroup agrupa as posições (índices em Playlist.Videos) de vídeos
+// considerados duplicados. A primeira posição é a que deve ser mantida.
+type DuplicateGroup struct {
+	Kind      DuplicateKind
+	Key       string
+	Positions []int
+}
+
+type CrossPlaylistDuplicate struct {
+	Video          Video
+	PlaylistIDs    []string
+	PlaylistTitles []string
+}
+
+// trechos entre parênteses/colchetes que só indicam o tipo de upload, ex: "(Official Video)", "[Lyric Video]"
+var titleNoisePattern = regexp.MustCompile(`(?i)[\(\[][^\)\]]*(\b(official|oficial|lyrics?|letra|audio|video|clipe|visualizer|hd|hq|4k|remaster(ed)?|legendado)\b|áudio|vídeo)[^\)\]]*[\)\]]`)
+
+var artistNoisePattern = regexp.MustCompile(`(?i)(\s*-\s*topic|vevo|\s+official)$`)
+
+func NormalizeArtist(artist string) string {
+	artist = artistNoisePattern.ReplaceAllString(strings.TrimSpace(artist), "")
+	return normalizeText(artist)
+}
+
+func NormalizeTitle(title, artist string) string {
+	title = titleNoisePattern.ReplaceAllString(title, " ")
+	title = normalizeText(title)
+
+	// "Artista - Música" enviado pelo canal do próprio artista vira apenas "música"
+	if normArtist := NormalizeArtist(artist); normArtist != "" {
+		title = strings.TrimPrefix(title, normArtist+" ")
+	}
+
+	return title
+}
+
+func normalizeText(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune(' ')
+		}
+	}
+
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+func (p *Playlist) FindDuplicates() []DuplicateGroup {
+	var groups []DuplicateGroup
+
+	// duplicatas exatas: mesmo ID de vídeo em mais de uma posição
+	positionsByID := make(map[string][]int)
+	var firstPositions []int
+	for i, video := range p.Videos {
+		if _, seen := positionsByID[video.ID]; !seen {
+			firstPositions = append(firstPositions, i)
+		}
+		positionsByID[video.ID] = append(positionsByID[video.ID], i)
+	}
+
+	for _, first := range firstPositions {
+		id := p.Videos[first].ID
+		if positions := positionsByID[id]; len(positions) > 1 {
+			groups = append(groups, DuplicateGroup{Kind: DuplicateExact, Key: id, Positions: positions})
+		}
+	}
+
+	// quase duplicatas: IDs diferentes com mesmo título e artista normalizados
+	positionsByKey := make(map[string][]int)
+	var keys []string
+	for _, first := range firstPositions {
+		video := p.Videos[first]
+		key := NormalizeArtist(video.Artist) + " | " + NormalizeTitle(video.Title, video.Artist)
+		if _, seen := positionsByKey[key]; !seen {
+			keys = append(keys, key)
+		}
+		positionsByKey[key] = append(positionsByKey[key], first)
+	}
+
+	for _, key := range keys {
+		if positions := positionsByKey[key]; len(positions) > 1 {
+			groups = append(groups, DuplicateGroup{Kind: DuplicateNear, Key: key, Positions: positions})
+		}
+	}
+
+	return groups
+}
+
+// DuplicatePositions retorna as posições a remover de cada grupo, mantendo a primeira ocorrência.
+func DuplicatePositions(groups []DuplicateGroup) []int {
+	seen := make(map[int]bool)
+	var positions []int
+	for _, group := range groups {
+		for _, pos := range group.Positions[1:] {
+			if !seen[pos] {
+				seen[pos] = true
+				positions = append(positions, pos)
+			}
+		}
+	}
+
+	sort.Ints(positions)
+	return positions
+}
+
+// RemoveAt remove os vídeos nas posições informadas e retorna os vídeos removidos.
+func (p *Playlist) RemoveAt(positions []int) []Video {
+	remove := make(map[int]bool, len(positions))
+	for _, pos := range positions {
+		remove[pos] = true
+	}
+
+	kept := make([]Video, 0, len(p.Videos))
+	var removed []Video
+	for i, video := range p.Videos {
+		if remove[i] {
+			removed = append(removed, video)
+			continue
+		}
+		kept = append(kept, video)
+	}
+
+	p.Videos = kept
+	return removed
+}
+
+func FindCrossPlaylistDuplicates(playlists []Playlist) []CrossPlaylistDuplicate {
+	byID := make(map[string]*CrossPlaylistDuplicate)
+	var order []string
+
+	for _, playlist := range playlists {
+		inPlaylist := make(map[string]bool)
+		for _, video := range playlist.Videos {
+			if inPlaylist[video.ID] {
+				continue
+			}
+			inPlaylist[video.ID] = true
+
+			entry, ok := byID[video.ID]
+			if !ok {
+				entry = &CrossPlaylistDuplicate{Video: video}
+				byID[video.ID] = entry
+				order = append(order, video.ID)
+			}
+			entry.PlaylistIDs = append(entry.PlaylistIDs, playlist.ID)
+			entry.PlaylistTitles = append(entry.PlaylistTitles, playlist.Title)
+		}
+	}
+
+	var report []CrossPlaylistDuplicate
+	for _, id := range order {
+		if entry := byID[id]; len(entry.PlaylistIDs) > 1 {
+			report = append(report, *entry)
+		}
+	}
+
+	sort.SliceStable(report, func(i, j int) bool {
+		return len(report[i].PlaylistIDs) > len(report[j].PlaylistIDs)
+	})
+
+	return report
+}
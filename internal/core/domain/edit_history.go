@@ -0,0 +1,110 @@
+package domain
+
+import "time"
+
+// HistoryEntry é um estado da playlist durante uma sessão de edição, com a descrição da
+// operação que o produziu. Held são os vídeos recortados que, nesse estado, estão fora da
+// playlist esperando ser colados.
+type HistoryEntry struct {
+	Description string
+	At          time.Time
+	Playlist    Playlist
+	Held        []Video
+}
+
+// EditHistory guarda os estados de uma sessão de edição em memória (movimentos, ordenações,
+// remoções, recortes) para desfazer, refazer ou voltar a qualquer estado anterior. Registrar
+// uma operação depois de desfazer descarta os estados que poderiam ser refeitos.
+type EditHistory struct {
+	entries []HistoryEntry
+	current int
+}
+
+// NewEditHistory começa o histórico com o estado inicial da playlist.
+func NewEditHistory(description string, playlist Playlist) *EditHistory {
+	h := &EditHistory{}
+	h.entries = append(h.entries, newHistoryEntry(description, playlist, nil))
+	return h
+}
+
+func newHistoryEntry(description string, playlist Playlist, held []Video) HistoryEntry {
+	// cópia dos vídeos: a playlist de quem chama continua sendo editada no lugar
+	playlist.Videos = append([]Video(nil), playlist.Videos...)
+	held = append([]Video(nil), held...)
+	return HistoryEntry{Description: description, At: time.Now(), Playlist: playlist, Held: held}
+}
+
+// Record registra o resultado de uma operação, com os vídeos recortados que ficaram fora da
+// playlist. Operações que não mudam nada (mover o primeiro vídeo para cima, por exemplo) não
+// entram no histórico.
+func (h *EditHistory) Record(description string, playlist Playlist, held []Video) bool {
+	entry := h.entries[h.current]
+	if sameOrder(entry.Playlist.Videos, playlist.Videos) && sameOrder(entry.Held, held) {
+		return false
+	}
+	h.entries = append(h.entries[:h.current+1], newHistoryEntry(description, playlist, held))
+	h.current++
+	return true
+}
+
+func (h *EditHistory) CanUndo() bool { return h.current > 0 }
+func (h *EditHistory) CanRedo() bool { return h.current < len(h.entries)-1 }
+
+// Undo volta um estado e devolve a playlist nele; false se já estiver no inicial.
+func (h *EditHistory) Undo() (Playlist, bool) {
+	if !h.CanUndo() {
+		return Playlist{}, false
+	}
+	return h.Jump(h.current - 1)
+}
+
+// Redo avança um estado desfeito; false se não houver o que refazer.
+func (h *EditHistory) Redo() (Playlist, bool) {
+	if !h.CanRedo() {
+		return Playlist{}, false
+	}
+	return h.Jump(h.current + 1)
+}
+
+// Jump vai direto para o estado index (0 = inicial), mantendo os demais para refazer.
+func (h *EditHistory) Jump(index int) (Playlist, bool) {
+	if index < 0 || index >= len(h.entries) {
+		return Playlist{}, false
+	}
+	h.current = index
+	return h.Playlist(), true
+}
+
+// Playlist devolve uma cópia do estado atual.
+func (h *EditHistory) Playlist() Playlist {
+	playlist := h.entries[h.current].Playlist
+	playlist.Videos = append([]Video(nil), playlist.Videos...)
+	return playlist
+}
+
+// Held devolve uma cópia dos vídeos recortados no estado atual.
+func (h *EditHistory) Held() []Video {
+	return append([]Video(nil), h.entries[h.current].Held...)
+}
+
+// Entries lista os estados do mais antigo ao mais novo; os posteriores a Current podem ser
+// refeitos.
+func (h *EditHistory) Entries() []HistoryEntry {
+	return h.entries
+}
+
+func (h *EditHistory) Current() int {
+	return h.current
+}
+
+func sameOrder(a, b []Video) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].ID != b[i].ID {
+			return false
+		}
+	}
+	return true
+}
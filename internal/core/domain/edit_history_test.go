@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func playlistOf(ids ...string) Playlist {
+	playlist := Playlist{ID: "PL1", Title: "Teste"}
+	for _, id := range ids {
+		playlist.Videos = append(playlist.Videos, Video{ID: id, Title: "Vídeo " + id})
+	}
+	return playlist
+}
+
+func videoIDs(videos []Video) []string {
+	ids := make([]string, 0, len(videos))
+	for _, video := range videos {
+		ids = append(ids, video.ID)
+	}
+	return ids
+}
+
+func TestEditHistoryUndoRedo(t *testing.T) {
+	h := NewEditHistory("Ordem atual", playlistOf("a", "b", "c"))
+	if h.CanUndo() || h.CanRedo() {
+		t.Fatal("histórico novo não deveria ter o que desfazer nem refazer")
+	}
+
+	h.Record("Ordenar", playlistOf("c", "b", "a"), nil)
+	h.Record("Remover: a", playlistOf("c", "b"), nil)
+	if h.Current() != 2 || len(h.Entries()) != 3 {
+		t.Fatalf("Current() = %d, Entries() = %d; esperado 2 e 3", h.Current(), len(h.Entries()))
+	}
+
+	playlist, ok := h.Undo()
+	if !ok || !reflect.DeepEqual(videoIDs(playlist.Videos), []string{"c", "b", "a"}) {
+		t.Fatalf("Undo() = %v, %v", videoIDs(playlist.Videos), ok)
+	}
+	playlist, _ = h.Undo()
+	if !reflect.DeepEqual(videoIDs(playlist.Videos), []string{"a", "b", "c"}) {
+		t.Fatalf("segundo Undo() = %v", videoIDs(playlist.Videos))
+	}
+	if _, ok := h.Undo(); ok {
+		t.Error("Undo() no estado inicial deveria falhar")
+	}
+
+	playlist, ok = h.Redo()
+	if !ok || !reflect.DeepEqual(videoIDs(playlist.Videos), []string{"c", "b", "a"}) {
+		t.Fatalf("Redo() = %v, %v", videoIDs(playlist.Videos), ok)
+	}
+	h.Redo()
+	if _, ok := h.Redo(); ok {
+		t.Error("Redo() no último estado deveria falhar")
+	}
+}
+
+func TestEditHistoryRecordTruncatesRedo(t *testing.T) {
+	h := NewEditHistory("Ordem atual", playlistOf("a", "b", "c"))
+	h.Record("Subir: b", playlistOf("b", "a", "c"), nil)
+	h.Record("Subir: c", playlistOf("b", "c", "a"), nil)
+	h.Undo()
+	h.Undo()
+
+	// uma operação nova depois de desfazer descarta o que poderia ser refeito
+	if !h.Record("Levar ao fim: a", playlistOf("b", "c", "a"), nil) {
+		t.Fatal("Record() não registrou a operação")
+	}
+	if h.CanRedo() {
+		t.Error("estados desfeitos continuaram disponíveis para refazer")
+	}
+	var descriptions []string
+	for _, entry := range h.Entries() {
+		descriptions = append(descriptions, entry.Description)
+	}
+	if want := []string{"Ordem atual", "Levar ao fim: a"}; !reflect.DeepEqual(descriptions, want) {
+		t.Errorf("Entries() = %v, esperado %v", descriptions, want)
+	}
+}
+
+func TestEditHistoryJump(t *testing.T) {
+	h := NewEditHistory("Ordem atual", playlistOf("a", "b"))
+	h.Record("Trocar", playlistOf("b", "a"), nil)
+	h.Record("Remover: a", playlistOf("b"), nil)
+
+	playlist, ok := h.Jump(0)
+	if !ok || h.Current() != 0 || !reflect.DeepEqual(videoIDs(playlist.Videos), []string{"a", "b"}) {
+		t.Fatalf("Jump(0) = %v, %v (atual %d)", videoIDs(playlist.Videos), ok, h.Current())
+	}
+	// voltar não apaga os estados seguintes
+	if playlist, ok := h.Jump(2); !ok || !reflect.DeepEqual(videoIDs(playlist.Videos), []string{"b"}) {
+		t.Fatalf("Jump(2) = %v, %v", videoIDs(playlist.Videos), ok)
+	}
+	if _, ok := h.Jump(3); ok {
+		t.Error("Jump() fora do histórico deveria falhar")
+	}
+	if _, ok := h.Jump(-1); ok {
+		t.Error("Jump(-1) deveria falhar")
+	}
+}
+
+func TestEditHistoryIgnoresNoOps(t *testing.T) {
+	h := NewEditHistory("Ordem atual", playlistOf("a", "b"))
+	if h.Record("Subir: a", playlistOf("a", "b"), nil) {
+		t.Error("Record() registrou uma operação que não mudou nada")
+	}
+
+	// recortar muda o estado mesmo que a ordem dos demais continue igual
+	held := playlistOf("b").Videos
+	if !h.Record("Recortar: b", playlistOf("a"), held) {
+		t.Fatal("Record() ignorou um recorte")
+	}
+	if got := videoIDs(h.Held()); !reflect.DeepEqual(got, []string{"b"}) {
+		t.Errorf("Held() = %v, esperado [b]", got)
+	}
+	h.Undo()
+	if len(h.Held()) != 0 {
+		t.Errorf("Held() depois de desfazer o recorte = %v", videoIDs(h.Held()))
+	}
+}
+
+func TestEditHistoryKeepsCopies(t *testing.T) {
+	playlist := playlistOf("a", "b")
+	h := NewEditHistory("Ordem atual", playlist)
+
+	// quem chama continua editando a própria playlist sem alterar o histórico
+	playlist.Videos[0], playlist.Videos[1] = playlist.Videos[1], playlist.Videos[0]
+	current := h.Playlist()
+	current.Videos[0].ID = "x"
+
+	if got := videoIDs(h.Playlist().Videos); !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Errorf("estado guardado foi alterado por fora: %v", got)
+	}
+}
@@ -0,0 +1,476 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Filter é uma expressão já compilada que decide se um vídeo entra ou não na playlist.
+//
+// Exemplo: duration < 10m and language in (pt, en) and published > 2022-01-01 and not artist ~ "Topic"
+type Filter struct {
+	expr  string
+	match func(Video) bool
+}
+
+func (f Filter) Match(video Video) bool {
+	if f.match == nil {
+		return true
+	}
+	return f.match(video)
+}
+
+func (f Filter) String() string {
+	return f.expr
+}
+
+// Apply retorna apenas os vídeos aceitos pelo filtro, mantendo a ordem original.
+func (f Filter) Apply(videos []Video) []Video {
+	var matched []Video
+	for _, video := range videos {
+		if f.Match(video) {
+			matched = append(matched, video)
+		}
+	}
+	return matched
+}
+
+type fieldKind int
+
+const (
+	fieldString fieldKind = iota
+	fieldDuration
+	fieldTime
+)
+
+type videoField struct {
+	kind     fieldKind
+	str      func(Video) string
+	duration func(Video) time.Duration
+	time     func(Video) time.Time
+}
+
+// videoFields são os campos de domain.Video que podem ser usados em filtros e ordenações.
+var videoFields = map[string]videoField{
+	"id":        {kind: fieldString, str: func(v Video) string { return v.ID }},
+	"title":     {kind: fieldString, str: func(v Video) string { return v.Title }},
+	"artist":    {kind: fieldString, str: func(v Video) string { return v.Artist }},
+	"language":  {kind: fieldString, str: func(v Video) string { return v.Language }},
+	"duration":  {kind: fieldDuration, duration: func(v Video) time.Duration { return v.Duration }},
+	"published": {kind: fieldTime, time: func(v Video) time.Time { return v.PublishedAt }},
+}
+
+func lookupField(name string) (videoField, error) {
+	field, ok := videoFields[strings.ToLower(name)]
+	if !ok {
+		return videoField{}, fmt.Errorf("campo desconhecido '%s' (use id, title, artist, language, duration ou published)", name)
+	}
+	return field, nil
+}
+
+func ParseFilter(expr string) (Filter, error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return Filter{}, nil
+	}
+
+	tokens, err := tokenizeFilter(expr)
+	if err != nil {
+		return Filter{}, err
+	}
+
+	p := &filterParser{tokens: tokens}
+	match, err := p.parseOr()
+	if err != nil {
+		return Filter{}, err
+	}
+
+	if !p.done() {
+		return Filter{}, fmt.Errorf("token inesperado '%s' na posição %d", p.peek().text, p.peek().pos)
+	}
+
+	return Filter{expr: expr, match: match}, nil
+}
+
+type filterTokenKind int
+
+const (
+	tokenWord filterTokenKind = iota
+	tokenString
+	tokenOperator
+	tokenLParen
+	tokenRParen
+	tokenComma
+)
+
+type filterToken struct {
+	kind filterTokenKind
+	text string
+	pos  int
+}
+
+func tokenizeFilter(expr string) ([]filterToken, error) {
+	var tokens []filterToken
+	runes := []rune(expr)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, filterToken{kind: tokenLParen, text: "(", pos: i})
+			i++
+		case r == ')':
+			tokens = append(tokens, filterToken{kind: tokenRParen, text: ")", pos: i})
+			i++
+		case r == ',':
+			tokens = append(tokens, filterToken{kind: tokenComma, text: ",", pos: i})
+			i++
+		case r == '"' || r == '\'':
+			end := i + 1
+			var b strings.Builder
+			for end < len(runes) && runes[end] != r {
+				if runes[end] == '\\' && end+1 < len(runes) {
+					end++
+				}
+				b.WriteRune(runes[end])
+				end++
+			}
+			if end >= len(runes) {
+				return nil, fmt.Errorf("string sem fechamento na posição %d", i)
+			}
+			tokens = append(tokens, filterToken{kind: tokenString, text: b.String(), pos: i})
+			i = end + 1
+		case strings.ContainsRune("<>=!~", r):
+			op := string(r)
+			if i+1 < len(runes) && strings.ContainsRune("=~", runes[i+1]) {
+				op += string(runes[i+1])
+			}
+			tokens = append(tokens, filterToken{kind: tokenOperator, text: op, pos: i})
+			i += len([]rune(op))
+		default:
+			start := i
+			for i < len(runes) && !unicode.IsSpace(runes[i]) && !strings.ContainsRune("(),<>=!~\"'", runes[i]) {
+				i++
+			}
+			tokens = append(tokens, filterToken{kind: tokenWord, text: string(runes[start:i]), pos: start})
+		}
+	}
+
+	return tokens, nil
+}
+
+type filterParser struct {
+	tokens []filterToken
+	pos    int
+}
+
+func (p *filterParser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *filterParser) peek() filterToken {
+	if p.done() {
+		return filterToken{}
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *filterParser) next() (filterToken, error) {
+	if p.done() {
+		return filterToken{}, fmt.Errorf("expressão terminou inesperadamente")
+	}
+	tok := p.tokens[p.pos]
+	p.pos++
+	return tok, nil
+}
+
+func (p *filterParser) peekKeyword(keyword string) bool {
+	tok := p.peek()
+	return !p.done() && tok.kind == tokenWord && strings.EqualFold(tok.text, keyword)
+}
+
+func (p *filterParser) parseOr() (func(Video) bool, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peekKeyword("or") {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		l := left
+		left = func(v Video) bool { return l(v) || right(v) }
+	}
+
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (func(Video) bool, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peekKeyword("and") {
+		p.pos++
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		l := left
+		left = func(v Video) bool { return l(v) && right(v) }
+	}
+
+	return left, nil
+}
+
+func (p *filterParser) parseUnary() (func(Video) bool, error) {
+	if p.peekKeyword("not") {
+		p.pos++
+		inner, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return func(v Video) bool { return !inner(v) }, nil
+	}
+
+	if p.peek().kind == tokenLParen && !p.done() {
+		p.pos++
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		tok, err := p.next()
+		if err != nil || tok.kind != tokenRParen {
+			return nil, fmt.Errorf("esperado ')' após a expressão")
+		}
+		return inner, nil
+	}
+
+	return p.parseComparison()
+}
+
+func (p *filterParser) parseComparison() (func(Video) bool, error) {
+	fieldTok, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	if fieldTok.kind != tokenWord {
+		return nil, fmt.Errorf("esperado nome de campo na posição %d, encontrado '%s'", fieldTok.pos, fieldTok.text)
+	}
+
+	field, err := lookupField(fieldTok.text)
+	if err != nil {
+		return nil, err
+	}
+
+	negated := false
+	if p.peekKeyword("not") {
+		p.pos++
+		negated = true
+		if !p.peekKeyword("in") {
+			return nil, fmt.Errorf("esperado 'in' após 'not' na posição %d", p.peek().pos)
+		}
+	}
+
+	if p.peekKeyword("in") {
+		p.pos++
+		values, err := p.parseList()
+		if err != nil {
+			return nil, err
+		}
+		match, err := compileIn(fieldTok.text, field, values)
+		if err != nil {
+			return nil, err
+		}
+		if negated {
+			return func(v Video) bool { return !match(v) }, nil
+		}
+		return match, nil
+	}
+
+	opTok, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	if opTok.kind != tokenOperator {
+		return nil, fmt.Errorf("esperado operador após '%s' na posição %d", fieldTok.text, opTok.pos)
+	}
+
+	valueTok, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	if valueTok.kind != tokenWord && valueTok.kind != tokenString {
+		return nil, fmt.Errorf("esperado valor após '%s' na posição %d", opTok.text, valueTok.pos)
+	}
+
+	return compileComparison(fieldTok.text, field, opTok.text, valueTok.text)
+}
+
+func (p *filterParser) parseList() ([]string, error) {
+	tok, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	if tok.kind != tokenLParen {
+		return nil, fmt.Errorf("esperado '(' após 'in' na posição %d", tok.pos)
+	}
+
+	var values []string
+	for {
+		tok, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+		if tok.kind != tokenWord && tok.kind != tokenString {
+			return nil, fmt.Errorf("esperado valor na lista na posição %d", tok.pos)
+		}
+		values = append(values, tok.text)
+
+		sep, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+		if sep.kind == tokenRParen {
+			return values, nil
+		}
+		if sep.kind != tokenComma {
+			return nil, fmt.Errorf("esperado ',' ou ')' na posição %d", sep.pos)
+		}
+	}
+}
+
+func compileIn(name string, field videoField, values []string) (func(Video) bool, error) {
+	var matchers []func(Video) bool
+	for _, value := range values {
+		match, err := compileComparison(name, field, "=", value)
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, match)
+	}
+
+	return func(v Video) bool {
+		for _, match := range matchers {
+			if match(v) {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+func compileComparison(name string, field videoField, op, value string) (func(Video) bool, error) {
+	switch field.kind {
+	case fieldDuration:
+		d, err := ParseFilterDuration(value)
+		if err != nil {
+			return nil, err
+		}
+		return compareOrdered(op, name, func(v Video) int { return compareInts(int64(field.duration(v)), int64(d)) })
+
+	case fieldTime:
+		t, err := ParseFilterDate(value)
+		if err != nil {
+			return nil, err
+		}
+		return compareOrdered(op, name, func(v Video) int { return field.time(v).Compare(t) })
+
+	default:
+		needle := strings.ToLower(value)
+		switch op {
+		case "~":
+			return func(v Video) bool { return strings.Contains(strings.ToLower(field.str(v)), needle) }, nil
+		case "!~":
+			return func(v Video) bool { return !strings.Contains(strings.ToLower(field.str(v)), needle) }, nil
+		}
+
+		equal := func(v Video) bool { return strings.EqualFold(field.str(v), value) }
+		if name == "language" {
+			// "pt" também aceita variantes regionais como "pt-BR"
+			equal = func(v Video) bool { return languageMatches(field.str(v), value) }
+		}
+
+		switch op {
+		case "=", "==":
+			return equal, nil
+		case "!=":
+			return func(v Video) bool { return !equal(v) }, nil
+		}
+
+		return compareOrdered(op, name, func(v Video) int { return strings.Compare(strings.ToLower(field.str(v)), needle) })
+	}
+}
+
+func compareOrdered(op, name string, cmp func(Video) int) (func(Video) bool, error) {
+	switch op {
+	case "<":
+		return func(v Video) bool { return cmp(v) < 0 }, nil
+	case "<=":
+		return func(v Video) bool { return cmp(v) <= 0 }, nil
+	case ">":
+		return func(v Video) bool { return cmp(v) > 0 }, nil
+	case ">=":
+		return func(v Video) bool { return cmp(v) >= 0 }, nil
+	case "=", "==":
+		return func(v Video) bool { return cmp(v) == 0 }, nil
+	case "!=":
+		return func(v Video) bool { return cmp(v) != 0 }, nil
+	default:
+		return nil, fmt.Errorf("operador '%s' não suportado para o campo '%s'", op, name)
+	}
+}
+
+func compareInts(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func languageMatches(language, wanted string) bool {
+	if strings.EqualFold(language, wanted) {
+		return true
+	}
+	base, _, found := strings.Cut(language, "-")
+	return found && !strings.Contains(wanted, "-") && strings.EqualFold(base, wanted)
+}
+
+// ParseFilterDuration aceita durações do Go ("10m", "1h30m") ou segundos inteiros ("90").
+func ParseFilterDuration(value string) (time.Duration, error) {
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("duração inválida '%s' (ex: 10m, 1h30m, 90)", value)
+	}
+	return d, nil
+}
+
+// ParseFilterDate aceita datas no formato 2006-01-02 ou RFC3339.
+func ParseFilterDate(value string) (time.Time, error) {
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("data inválida '%s' (ex: 2022-01-01)", value)
+	}
+	return t, nil
+}
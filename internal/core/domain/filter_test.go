@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func filterVideos() []Video {
+	date := func(value string) time.Time {
+		t, _ := time.Parse(time.DateOnly, value)
+		return t
+	}
+	return []Video{
+		{ID: "curto-pt", Title: "Samba curto", Artist: "Cartola", Language: "pt-BR", Duration: 4 * time.Minute, PublishedAt: date("2023-05-10")},
+		{ID: "curto-en", Title: "Short song", Artist: "Band", Language: "en", Duration: 3 * time.Minute, PublishedAt: date("2022-06-01")},
+		{ID: "topic", Title: "Auto song", Artist: "Band - Topic", Language: "en", Duration: 5 * time.Minute, PublishedAt: date("2023-01-01")},
+		{ID: "longo", Title: "Live concert", Artist: "Cartola", Language: "pt", Duration: 90 * time.Minute, PublishedAt: date("2023-03-03")},
+		{ID: "antigo", Title: "Old song", Artist: "Band", Language: "es", Duration: 2 * time.Minute, PublishedAt: date("2019-01-01")},
+	}
+}
+
+func matchedIDs(t *testing.T, expr string) []string {
+	t.Helper()
+	filter, err := ParseFilter(expr)
+	if err != nil {
+		t.Fatalf("ParseFilter(%q) erro = %v", expr, err)
+	}
+	var ids []string
+	for _, video := range filter.Apply(filterVideos()) {
+		ids = append(ids, video.ID)
+	}
+	return ids
+}
+
+func TestParseFilterMatches(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+		want []string
+	}{
+		{
+			name: "exemplo da documentação",
+			expr: `duration < 10m and language in (pt, en) and published > 2022-01-01 and not artist ~ "Topic"`,
+			want: []string{"curto-pt", "curto-en"},
+		},
+		{"vazio aceita tudo", "  ", []string{"curto-pt", "curto-en", "topic", "longo", "antigo"}},
+		{"and antes de or", `language = es or language = pt and duration > 1h`, []string{"longo", "antigo"}},
+		{"parênteses mudam a precedência", `(language = es or language = pt) and duration < 1h`, []string{"curto-pt", "antigo"}},
+		{"not antes de and", `not language = en and duration < 10m`, []string{"curto-pt", "antigo"}},
+		{"not in", `language not in (pt, en)`, []string{"antigo"}},
+		{"idioma aceita variantes regionais", `language = pt`, []string{"curto-pt", "longo"}},
+		{"idioma regional não aceita o genérico", `language = pt-BR`, []string{"curto-pt"}},
+		{"contém sem diferenciar maiúsculas", `title ~ SONG`, []string{"curto-en", "topic", "antigo"}},
+		{"não contém", `artist !~ band`, []string{"curto-pt", "longo"}},
+		{"string com aspas simples e escape", `title = 'Samba curto'`, []string{"curto-pt"}},
+		{"duração em segundos", `duration <= 180`, []string{"curto-en", "antigo"}},
+		{"duração composta", `duration >= 1h30m`, []string{"longo"}},
+		{"data RFC3339", `published < 2022-06-01T00:00:01Z`, []string{"curto-en", "antigo"}},
+		{"campo sem diferenciar maiúsculas", `ARTIST == cartola`, []string{"curto-pt", "longo"}},
+		{"diferente", `artist != Band`, []string{"curto-pt", "topic", "longo"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := matchedIDs(t, tt.expr)
+			if len(got) != len(tt.want) {
+				t.Fatalf("ParseFilter(%q) aceitou %v, esperado %v", tt.expr, got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("ParseFilter(%q) aceitou %v, esperado %v", tt.expr, got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestParseFilterErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+	}{
+		{"campo desconhecido", `views > 10`},
+		{"sem operador", `duration 10m`},
+		{"sem valor", `duration <`},
+		{"duração inválida", `duration < abc`},
+		{"data inválida", `published > 2022-13-01`},
+		{"operador sem sentido para duração", `duration ~ 10m`},
+		{"string sem fechamento", `title ~ "Samba`},
+		{"parêntese sem fechamento", `(duration < 10m`},
+		{"parêntese a mais", `duration < 10m)`},
+		{"in sem lista", `language in pt`},
+		{"lista sem vírgula", `language in (pt en)`},
+		{"lista sem fechamento", `language in (pt,`},
+		{"not sem in", `language not pt`},
+		{"and sem lado direito", `duration < 10m and`},
+		{"começa com operador", `= 10m`},
+		{"dois valores", `title = a b`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseFilter(tt.expr); err == nil {
+				t.Errorf("ParseFilter(%q) aceitou uma expressão inválida", tt.expr)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+)
+
+// JobStatus é o estado de uma operação remota (busca ou gravação) no diário de operações.
+type JobStatus string
+
+const (
+	JobStarted   JobStatus = "started"
+	JobSucceeded JobStatus = "succeeded"
+	JobFailed    JobStatus = "failed"
+	JobCancelled JobStatus = "cancelled"
+	JobTimedOut  JobStatus = "timed_out"
+)
+
+// JobEvent é uma linha do diário: o início ou o fim de uma operação, identificada pelo
+// mesmo ID de Operation usado nos logs.
+type JobEvent struct {
+	OperationID string
+	Name        string
+	Status      JobStatus
+	At          time.Time
+	Duration    time.Duration // do início até este evento; zero no início
+	Err         string
+}
+
+// Job resume os eventos de uma operação. Sem evento de fim, o status continua JobStarted:
+// a operação está em andamento ou o programa foi encerrado no meio dela.
+type Job struct {
+	OperationID string
+	Name        string
+	Status      JobStatus
+	StartedAt   time.Time
+	Duration    time.Duration
+	Err         string
+}
+
+// JobStatusFor classifica o resultado de uma operação: cancelada pelo usuário, prazo
+// esgotado, falha ou sucesso.
+func JobStatusFor(err error) JobStatus {
+	switch {
+	case err == nil:
+		return JobSucceeded
+	case errors.Is(err, context.Canceled):
+		return JobCancelled
+	case errors.Is(err, context.DeadlineExceeded):
+		return JobTimedOut
+	default:
+		return JobFailed
+	}
+}
+
+// SummarizeJobs junta os eventos por operação, na ordem de início.
+func SummarizeJobs(events []JobEvent) []Job {
+	byID := make(map[string]*Job)
+	var jobs []*Job
+	for _, event := range events {
+		job, ok := byID[event.OperationID]
+		if !ok {
+			job = &Job{OperationID: event.OperationID, Name: event.Name, Status: JobStarted, StartedAt: event.At.Add(-event.Duration)}
+			byID[event.OperationID] = job
+			jobs = append(jobs, job)
+		}
+		if event.Status == JobStarted {
+			job.StartedAt = event.At
+			continue
+		}
+		job.Status = event.Status
+		job.Duration = event.Duration
+		job.Err = event.Err
+	}
+
+	sort.SliceStable(jobs, func(i, j int) bool { return jobs[i].StartedAt.Before(jobs[j].StartedAt) })
+
+	result := make([]Job, len(jobs))
+	for i, job := range jobs {
+		result[i] = *job
+	}
+	return result
+}
+
+type writesStartedKey struct{}
+
+// OnWritesStarted devolve um contexto filho que chama fn quando a operação começar a gravar
+// no YouTube (ver BeginWrites).
+func OnWritesStarted(ctx context.Context, fn func()) context.Context {
+	return context.WithValue(ctx, writesStartedKey{}, fn)
+}
+
+// BeginWrites marca o início das gravações de uma operação. Cancelar ou estourar o prazo
+// continua valendo depois disso: a gravação para antes da próxima escrita (uma escrita já
+// enviada não é interrompida, ver o provider) e quem chamou limpa ou informa o que ficou
+// pela metade, como a cópia apagada ou o snapshot da ordem anterior.
+func BeginWrites(ctx context.Context) (context.Context, error) {
+	if err := ctx.Err(); err != nil {
+		return ctx, err
+	}
+	if fn, ok := ctx.Value(writesStartedKey{}).(func()); ok {
+		fn()
+	}
+	return ctx, nil
+}
@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestBeginWritesKeepsCancellationAndDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(StartOperation(context.Background(), "reorder"), time.Hour)
+	defer cancel()
+
+	started := false
+	ctx = OnWritesStarted(ctx, func() { started = true })
+
+	writes, err := BeginWrites(ctx)
+	if err != nil {
+		t.Fatalf("BeginWrites() erro = %v", err)
+	}
+	if !started {
+		t.Error("BeginWrites() não avisou o início das gravações")
+	}
+
+	if _, ok := writes.Deadline(); !ok {
+		t.Error("contexto das gravações perdeu o prazo da operação")
+	}
+	cancel()
+	if !errors.Is(writes.Err(), context.Canceled) {
+		t.Errorf("contexto das gravações não foi cancelado com a operação: %v", writes.Err())
+	}
+	if op, ok := OperationFrom(writes); !ok || op.Name != "reorder" {
+		t.Errorf("contexto das gravações perdeu a operação: %+v", op)
+	}
+}
+
+func TestBeginWritesAfterCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	started := false
+	ctx = OnWritesStarted(ctx, func() { started = true })
+	if _, err := BeginWrites(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("BeginWrites() erro = %v, esperado context.Canceled", err)
+	}
+	if started {
+		t.Error("gravações marcadas como iniciadas numa operação já cancelada")
+	}
+}
+
+func TestJobStatusFor(t *testing.T) {
+	tests := []struct {
+		err  error
+		want JobStatus
+	}{
+		{nil, JobSucceeded},
+		{errors.New("quota"), JobFailed},
+		{fmt.Errorf("fetch: %w", context.Canceled), JobCancelled},
+		{fmt.Errorf("fetch: %w", context.DeadlineExceeded), JobTimedOut},
+	}
+	for _, tt := range tests {
+		if got := JobStatusFor(tt.err); got != tt.want {
+			t.Errorf("JobStatusFor(%v) = %s, esperado %s", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestSummarizeJobs(t *testing.T) {
+	start := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	events := []JobEvent{
+		{OperationID: "b", Name: "reorder", Status: JobStarted, At: start.Add(time.Minute)},
+		{OperationID: "a", Name: "fetch", Status: JobStarted, At: start},
+		{OperationID: "a", Name: "fetch", Status: JobCancelled, At: start.Add(2 * time.Second), Duration: 2 * time.Second, Err: "context canceled"},
+	}
+
+	jobs := SummarizeJobs(events)
+	if len(jobs) != 2 {
+		t.Fatalf("SummarizeJobs() = %d operações, esperado 2", len(jobs))
+	}
+	if jobs[0].OperationID != "a" || jobs[0].Status != JobCancelled || jobs[0].Duration != 2*time.Second {
+		t.Errorf("primeira operação = %+v", jobs[0])
+	}
+	if jobs[1].OperationID != "b" || jobs[1].Status != JobStarted {
+		t.Errorf("operação sem fim = %+v", jobs[1])
+	}
+}
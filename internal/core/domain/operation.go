@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+)
+
+// Operation identifica uma ação disparada pelo usuário (buscar playlists, reordenar,
+// login...). O ID viaja no context.Context e aparece em toda linha de log e em todo erro
+// da operação, permitindo separar operações simultâneas nos logs.
+type Operation struct {
+	ID   string
+	Name string
+}
+
+type operationKey struct{}
+
+// StartOperation devolve um contexto filho com uma operação nova.
+func StartOperation(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, operationKey{}, Operation{ID: newOperationID(), Name: name})
+}
+
+// OperationFrom devolve a operação do contexto, se houver.
+func OperationFrom(ctx context.Context) (Operation, bool) {
+	if ctx == nil {
+		return Operation{}, false
+	}
+	op, ok := ctx.Value(operationKey{}).(Operation)
+	return op, ok
+}
+
+// OperationError anexa a operação a um erro; a mensagem termina com "[op <id>]", o ID a
+// ser usado no comando logs.
+type OperationError struct {
+	Operation Operation
+	Err       error
+}
+
+func (e *OperationError) Error() string {
+	return fmt.Sprintf("%v [op %s]", e.Err, e.Operation.ID)
+}
+
+func (e *OperationError) Unwrap() error {
+	return e.Err
+}
+
+// TagError anexa a operação do contexto ao erro. Erros nil, sem operação no contexto ou
+// que já carregam a mesma operação são devolvidos sem mudança.
+func TagError(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+	op, ok := OperationFrom(ctx)
+	if !ok {
+		return err
+	}
+
+	var tagged *OperationError
+	if errors.As(err, &tagged) && tagged.Operation.ID == op.ID {
+		return err
+	}
+	return &OperationError{Operation: op, Err: err}
+}
+
+func newOperationID() string {
+	b := make([]byte, 4)
+	if _, err := rand.Read(b); err != nil {
+		return "00000000"
+	}
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,29 @@
+package domain
+
+import "fmt"
+
+// ApplyOrder reordena os vídeos conforme a lista de IDs, exigindo que ela seja uma
+// permutação exata dos vídeos atuais (mesmos IDs, mesma quantidade de cada um).
+func (p *Playlist) ApplyOrder(videoIDs []string) error {
+	if len(videoIDs) != len(p.Videos) {
+		return fmt.Errorf("ordem com %d vídeos, mas a playlist tem %d", len(videoIDs), len(p.Videos))
+	}
+
+	pending := make(map[string][]Video, len(p.Videos))
+	for _, video := range p.Videos {
+		pending[video.ID] = append(pending[video.ID], video)
+	}
+
+	ordered := make([]Video, 0, len(videoIDs))
+	for _, id := range videoIDs {
+		videos := pending[id]
+		if len(videos) == 0 {
+			return fmt.Errorf("ID de vídeo '%s' não existe na playlist ou aparece mais vezes que o original", id)
+		}
+		ordered = append(ordered, videos[0])
+		pending[id] = videos[1:]
+	}
+
+	p.Videos = ordered
+	return nil
+}
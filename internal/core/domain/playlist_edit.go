@@ -0,0 +1,70 @@
+package domain
+
+import "sort"
+
+// InsertAt insere os vídeos a partir da posição index (limitada ao tamanho da playlist),
+// preservando a ordem recebida.
+func (p *Playlist) InsertAt(index int, videos []Video) {
+	index = clampPosition(index, len(p.Videos))
+
+	result := make([]Video, 0, len(p.Videos)+len(videos))
+	result = append(result, p.Videos[:index]...)
+	result = append(result, videos...)
+	result = append(result, p.Videos[index:]...)
+	p.Videos = result
+}
+
+// MoveTo tira os vídeos das posições informadas e os reinsere juntos, na ordem em que
+// estavam, a partir da posição target da playlist resultante. Devolve as novas posições
+// dos vídeos movidos.
+func (p *Playlist) MoveTo(positions []int, target int) []int {
+	positions = validPositions(positions, len(p.Videos))
+	if len(positions) == 0 {
+		return nil
+	}
+
+	moved := p.RemoveAt(positions)
+	target = clampPosition(target, len(p.Videos))
+	p.InsertAt(target, moved)
+
+	result := make([]int, len(moved))
+	for i := range moved {
+		result[i] = target + i
+	}
+	return result
+}
+
+// MoveBy desloca os vídeos das posições informadas delta posições (negativo sobe), como um
+// bloco a partir do primeiro deles.
+func (p *Playlist) MoveBy(positions []int, delta int) []int {
+	positions = validPositions(positions, len(p.Videos))
+	if len(positions) == 0 {
+		return nil
+	}
+	return p.MoveTo(positions, positions[0]+delta)
+}
+
+// validPositions ordena as posições e descarta repetidas ou fora da playlist.
+func validPositions(positions []int, size int) []int {
+	seen := make(map[int]bool, len(positions))
+	var valid []int
+	for _, pos := range positions {
+		if pos < 0 || pos >= size || seen[pos] {
+			continue
+		}
+		seen[pos] = true
+		valid = append(valid, pos)
+	}
+	sort.Ints(valid)
+	return valid
+}
+
+func clampPosition(index, size int) int {
+	if index < 0 {
+		return 0
+	}
+	if index > size {
+		return size
+	}
+	return index
+}
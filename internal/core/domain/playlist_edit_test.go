@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPlaylistInsertAt(t *testing.T) {
+	tests := []struct {
+		name  string
+		index int
+		want  []string
+	}{
+		{"no início", 0, []string{"x", "y", "a", "b", "c"}},
+		{"no meio", 1, []string{"a", "x", "y", "b", "c"}},
+		{"no fim", 3, []string{"a", "b", "c", "x", "y"}},
+		{"antes do início vira o início", -2, []string{"x", "y", "a", "b", "c"}},
+		{"depois do fim vira o fim", 10, []string{"a", "b", "c", "x", "y"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			playlist := playlistOf("a", "b", "c")
+			playlist.InsertAt(tt.index, playlistOf("x", "y").Videos)
+			if got := videoIDs(playlist.Videos); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("InsertAt(%d) = %v, esperado %v", tt.index, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlaylistMoveTo(t *testing.T) {
+	tests := []struct {
+		name      string
+		positions []int
+		target    int
+		want      []string
+		moved     []int
+	}{
+		{"um para o topo", []int{3}, 0, []string{"d", "a", "b", "c", "e"}, []int{0}},
+		{"um para o fim", []int{0}, 4, []string{"b", "c", "d", "e", "a"}, []int{4}},
+		{"vários juntos na ordem original", []int{4, 1}, 0, []string{"b", "e", "a", "c", "d"}, []int{0, 1}},
+		{"alvo depois do fim", []int{0, 1}, 10, []string{"c", "d", "e", "a", "b"}, []int{3, 4}},
+		{"repetidas e fora da playlist são ignoradas", []int{2, 2, -1, 9}, 0, []string{"c", "a", "b", "d", "e"}, []int{0}},
+		{"nenhuma posição válida", []int{7}, 0, []string{"a", "b", "c", "d", "e"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			playlist := playlistOf("a", "b", "c", "d", "e")
+			moved := playlist.MoveTo(tt.positions, tt.target)
+			if got := videoIDs(playlist.Videos); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MoveTo(%v, %d) = %v, esperado %v", tt.positions, tt.target, got, tt.want)
+			}
+			if !reflect.DeepEqual(moved, tt.moved) {
+				t.Errorf("MoveTo(%v, %d) devolveu %v, esperado %v", tt.positions, tt.target, moved, tt.moved)
+			}
+		})
+	}
+}
+
+func TestPlaylistMoveBy(t *testing.T) {
+	tests := []struct {
+		name      string
+		positions []int
+		delta     int
+		want      []string
+		moved     []int
+	}{
+		{"sobe um", []int{2}, -1, []string{"a", "c", "b", "d"}, []int{1}},
+		{"desce um", []int{1}, 1, []string{"a", "c", "b", "d"}, []int{2}},
+		{"primeiro não sobe mais", []int{0}, -1, []string{"a", "b", "c", "d"}, []int{0}},
+		{"último não desce mais", []int{3}, 1, []string{"a", "b", "c", "d"}, []int{3}},
+		{"bloco a partir do primeiro marcado", []int{1, 3}, -1, []string{"b", "d", "a", "c"}, []int{0, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			playlist := playlistOf("a", "b", "c", "d")
+			moved := playlist.MoveBy(tt.positions, tt.delta)
+			if got := videoIDs(playlist.Videos); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MoveBy(%v, %d) = %v, esperado %v", tt.positions, tt.delta, got, tt.want)
+			}
+			if !reflect.DeepEqual(moved, tt.moved) {
+				t.Errorf("MoveBy(%v, %d) devolveu %v, esperado %v", tt.positions, tt.delta, moved, tt.moved)
+			}
+		})
+	}
+}
+
+func TestPlaylistRemoveAt(t *testing.T) {
+	playlist := playlistOf("a", "b", "c", "d")
+	removed := playlist.RemoveAt([]int{3, 1, 1, 8})
+
+	if got := videoIDs(removed); !reflect.DeepEqual(got, []string{"b", "d"}) {
+		t.Errorf("RemoveAt() devolveu %v, esperado [b d]", got)
+	}
+	if got := videoIDs(playlist.Videos); !reflect.DeepEqual(got, []string{"a", "c"}) {
+		t.Errorf("playlist depois de RemoveAt() = %v, esperado [a c]", got)
+	}
+}
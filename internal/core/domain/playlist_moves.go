@@ -0,0 +1,93 @@
+package domain
+
+import "sort"
+
+// ItemMove leva o item ItemID para a posição Position (a partir de 0) da playlist, como o
+// snippet.position de PlaylistItems.Update: o item sai de onde está e os demais se ajustam.
+type ItemMove struct {
+	ItemID   string
+	Position int
+}
+
+// PlanMoves calcula as mudanças de posição que levam a ordem current à ordem target, que
+// devem ter os mesmos itens (IDs únicos). Os itens da maior subsequência que já está na
+// ordem certa ficam onde estão; cada um dos outros é colocado logo depois do item que o
+// antecede em target, na ordem de target.
+func PlanMoves(current, target []string) []ItemMove {
+	targetIndex := make(map[string]int, len(target))
+	for i, id := range target {
+		targetIndex[id] = i
+	}
+
+	sequence := make([]int, 0, len(current))
+	for _, id := range current {
+		if index, ok := targetIndex[id]; ok {
+			sequence = append(sequence, index)
+		}
+	}
+	stable := make(map[int]bool)
+	for _, index := range longestIncreasing(sequence) {
+		stable[index] = true
+	}
+
+	order := append([]string(nil), current...)
+	var moves []ItemMove
+	for i, id := range target {
+		if stable[i] {
+			continue
+		}
+
+		from := indexOf(order, id)
+		if from < 0 {
+			continue
+		}
+		order = append(order[:from], order[from+1:]...)
+
+		position := 0
+		if i > 0 {
+			position = indexOf(order, target[i-1]) + 1
+		}
+		order = append(order[:position], append([]string{id}, order[position:]...)...)
+		moves = append(moves, ItemMove{ItemID: id, Position: position})
+	}
+	return moves
+}
+
+// longestIncreasing devolve os valores de uma maior subsequência estritamente crescente.
+func longestIncreasing(values []int) []int {
+	// tails[k] é o índice (em values) do menor final de uma subsequência de tamanho k+1
+	var tails []int
+	previous := make([]int, len(values))
+	for i, value := range values {
+		k := sort.Search(len(tails), func(j int) bool { return values[tails[j]] >= value })
+		previous[i] = -1
+		if k > 0 {
+			previous[i] = tails[k-1]
+		}
+		if k == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[k] = i
+		}
+	}
+
+	if len(tails) == 0 {
+		return nil
+	}
+	result := make([]int, len(tails))
+	k := tails[len(tails)-1]
+	for i := len(result) - 1; i >= 0; i-- {
+		result[i] = values[k]
+		k = previous[k]
+	}
+	return result
+}
+
+func indexOf(ids []string, id string) int {
+	for i, candidate := range ids {
+		if candidate == id {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"math/rand"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+// applyMoves reproduz o efeito de PlaylistItems.Update com snippet.position.
+func applyMoves(order []string, moves []ItemMove) []string {
+	result := append([]string(nil), order...)
+	for _, move := range moves {
+		from := indexOf(result, move.ItemID)
+		result = append(result[:from], result[from+1:]...)
+		result = append(result[:move.Position], append([]string{move.ItemID}, result[move.Position:]...)...)
+	}
+	return result
+}
+
+func TestPlanMoves(t *testing.T) {
+	tests := []struct {
+		name    string
+		current []string
+		target  []string
+		moves   int
+	}{
+		{"mesma ordem", []string{"a", "b", "c"}, []string{"a", "b", "c"}, 0},
+		{"vazia", nil, nil, 0},
+		{"último para o topo", []string{"b", "c", "d", "e", "a"}, []string{"a", "b", "c", "d", "e"}, 1},
+		{"primeiro para o fim", []string{"e", "a", "b", "c", "d"}, []string{"a", "b", "c", "d", "e"}, 1},
+		{"troca de dois vizinhos", []string{"a", "c", "b", "d"}, []string{"a", "b", "c", "d"}, 1},
+		{"invertida", []string{"d", "c", "b", "a"}, []string{"a", "b", "c", "d"}, 3},
+		{"bloco no meio", []string{"a", "d", "e", "b", "c", "f"}, []string{"a", "b", "c", "d", "e", "f"}, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			moves := PlanMoves(tt.current, tt.target)
+			if len(moves) != tt.moves {
+				t.Errorf("PlanMoves() = %d movimentos %v, esperado %d", len(moves), moves, tt.moves)
+			}
+			if got := applyMoves(tt.current, moves); len(tt.target) > 0 && !reflect.DeepEqual(got, tt.target) {
+				t.Errorf("ordem depois dos movimentos = %v, esperado %v", got, tt.target)
+			}
+		})
+	}
+}
+
+func TestPlanMovesRandomOrders(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	for round := 0; round < 200; round++ {
+		size := random.Intn(30)
+		current := make([]string, size)
+		for i := range current {
+			current[i] = strconv.Itoa(i)
+		}
+		target := append([]string(nil), current...)
+		random.Shuffle(len(target), func(i, j int) { target[i], target[j] = target[j], target[i] })
+
+		moves := PlanMoves(current, target)
+		if got := applyMoves(current, moves); size > 0 && !reflect.DeepEqual(got, target) {
+			t.Fatalf("ordem depois dos movimentos = %v, esperado %v", got, target)
+		}
+
+		// só se movem os itens fora da maior subsequência já em ordem
+		sequence := make([]int, size)
+		for i, id := range current {
+			sequence[i] = indexOf(target, id)
+		}
+		if want := size - len(longestIncreasing(sequence)); len(moves) != want {
+			t.Fatalf("PlanMoves() = %d movimentos, esperado %d", len(moves), want)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package domain
+
+import "context"
+
+// Etapas de uma gravação no YouTube informadas em ProgressEvent.
+const (
+	ProgressCreate = "create" // criação da playlist nova
+	ProgressRemove = "remove" // remoção de um item antigo
+	ProgressInsert = "insert" // inserção de um vídeo que não estava na playlist
+	ProgressMove   = "move"   // mudança de posição de um item existente
+)
+
+// ProgressEvent é emitido pelo provider depois de cada item gravado. Done conta os itens
+// concluídos de Total, somando todas as etapas da gravação.
+type ProgressEvent struct {
+	Stage string
+	Done  int
+	Total int
+	Title string // vídeo do item concluído
+}
+
+// ProgressFunc recebe os eventos de uma gravação; é chamada na goroutine que grava.
+type ProgressFunc func(ProgressEvent)
+
+type progressKey struct{}
+
+// WithProgress devolve um contexto filho cujas gravações informam o progresso a fn.
+func WithProgress(ctx context.Context, fn ProgressFunc) context.Context {
+	return context.WithValue(ctx, progressKey{}, fn)
+}
+
+// ReportProgress repassa o evento à função do contexto, se houver.
+func ReportProgress(ctx context.Context, event ProgressEvent) {
+	if ctx == nil {
+		return
+	}
+	if fn, ok := ctx.Value(progressKey{}).(ProgressFunc); ok {
+		fn(event)
+	}
+}
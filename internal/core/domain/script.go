@@ -0,0 +1,9 @@
+package domain
+
+// Script é uma receita de ordenação e/ou filtro salva na biblioteca local de scripts.
+type Script struct {
+	Name        string
+	Description string
+	CanSort     bool
+	CanFilter   bool
+}
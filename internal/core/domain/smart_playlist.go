@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// SmartPlaylist é uma definição salva localmente que gera uma playlist a partir
+// de uma ou mais playlists de origem, um filtro e uma ordenação.
+type SmartPlaylist struct {
+	Name             string
+	Title            string
+	Sources          []string
+	Filter           string
+	FilterScript     string
+	Sort             string
+	TargetPlaylistID string
+	LastBuiltAt      time.Time
+}
+
+func (s SmartPlaylist) Validate() error {
+	if strings.TrimSpace(s.Name) == "" {
+		return fmt.Errorf("smart playlist precisa de um nome")
+	}
+	if len(s.Sources) == 0 {
+		return fmt.Errorf("smart playlist '%s' precisa de pelo menos uma playlist de origem", s.Name)
+	}
+	if _, err := ParseFilter(s.Filter); err != nil {
+		return fmt.Errorf("filtro inválido: %w", err)
+	}
+	if _, err := ParseSortSpec(s.Sort); err != nil {
+		return fmt.Errorf("ordenação inválida: %w", err)
+	}
+	return nil
+}
+
+// Build junta os vídeos das playlists de origem (sem repetir IDs), aplica o filtro e a ordenação.
+func (s SmartPlaylist) Build(sources []Playlist) (Playlist, error) {
+	filter, err := ParseFilter(s.Filter)
+	if err != nil {
+		return Playlist{}, fmt.Errorf("filtro inválido: %w", err)
+	}
+
+	keys, err := ParseSortSpec(s.Sort)
+	if err != nil {
+		return Playlist{}, fmt.Errorf("ordenação inválida: %w", err)
+	}
+
+	title := s.Title
+	if title == "" {
+		title = s.Name
+	}
+
+	playlist := Playlist{ID: s.TargetPlaylistID, Title: title}
+	seen := make(map[string]bool)
+	for _, source := range sources {
+		for _, video := range source.Videos {
+			if seen[video.ID] {
+				continue
+			}
+			seen[video.ID] = true
+			playlist.Videos = append(playlist.Videos, video)
+		}
+	}
+
+	playlist.Videos = filter.Apply(playlist.Videos)
+	playlist.SortByKeys(keys)
+
+	return playlist, nil
+}
@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type SortKey struct {
+	Field      string
+	Descending bool
+}
+
+// ParseSortSpec interpreta especificações como "duration desc, title".
+func ParseSortSpec(spec string) ([]SortKey, error) {
+	var keys []SortKey
+
+	for _, part := range strings.Split(spec, ",") {
+		words := strings.Fields(part)
+		if len(words) == 0 {
+			continue
+		}
+		if len(words) > 2 {
+			return nil, fmt.Errorf("ordenação inválida '%s' (ex: duration desc, title)", strings.TrimSpace(part))
+		}
+
+		if _, err := lookupField(words[0]); err != nil {
+			return nil, err
+		}
+
+		key := SortKey{Field: strings.ToLower(words[0])}
+		if len(words) == 2 {
+			switch strings.ToLower(words[1]) {
+			case "asc":
+			case "desc":
+				key.Descending = true
+			default:
+				return nil, fmt.Errorf("direção de ordenação inválida '%s' (use asc ou desc)", words[1])
+			}
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+func compareVideos(a, b Video, key SortKey) int {
+	field := videoFields[key.Field]
+
+	var result int
+	switch field.kind {
+	case fieldDuration:
+		result = compareInts(int64(field.duration(a)), int64(field.duration(b)))
+	case fieldTime:
+		result = field.time(a).Compare(field.time(b))
+	default:
+		result = strings.Compare(strings.ToLower(field.str(a)), strings.ToLower(field.str(b)))
+	}
+
+	if key.Descending {
+		return -result
+	}
+	return result
+}
+
+// SortByKeys ordena os vídeos pelas chaves informadas, em ordem de prioridade, mantendo a ordem original nos empates.
+func (p *Playlist) SortByKeys(keys []SortKey) {
+	sort.SliceStable(p.Videos, func(i, j int) bool {
+		for _, key := range keys {
+			if c := compareVideos(p.Videos[i], p.Videos[j], key); c != 0 {
+				return c < 0
+			}
+		}
+		return false
+	})
+}
@@ -4,6 +4,7 @@ import "time"
 
 type Video struct {
 	ID          string
+	ItemID      string
 	Title       string
 	Artist      string
 	PublishedAt time.Time
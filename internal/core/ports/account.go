@@ -0,0 +1,8 @@
+package ports
+
+// AccountPort dá acesso às contas (perfis) configuradas, cada uma com seu próprio token.
+type AccountPort interface {
+	ListProfiles() ([]string, error)
+	HasToken(profile string) bool
+	YoutubeFor(profile string) (YoutubePort, error)
+}
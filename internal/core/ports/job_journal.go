@@ -0,0 +1,10 @@
+package ports
+
+import "TUI_playlist_reorder/internal/core/domain"
+
+// JobJournalPort registra o início e o fim (sucesso, erro, cancelamento ou prazo esgotado)
+// das operações remotas.
+type JobJournalPort interface {
+	Record(event domain.JobEvent) error
+	Events() ([]domain.JobEvent, error)
+}
@@ -1,8 +1,19 @@
 package ports
 
+import "context"
+
+// LoggerPort recebe, além da mensagem, atributos chave/valor (ex.: "playlist_id", id). As
+// variantes ...Context incluem nos logs a operação do contexto (domain.StartOperation).
 type LoggerPort interface {
-	Info(msg string)
-	Error(msg string, err error)
-	Warning(msg string)
+	Trace(msg string, attrs ...any)
+	Debug(msg string, attrs ...any)
+	Info(msg string, attrs ...any)
+	Error(msg string, err error, attrs ...any)
+	Warning(msg string, attrs ...any)
+	TraceContext(ctx context.Context, msg string, attrs ...any)
+	DebugContext(ctx context.Context, msg string, attrs ...any)
+	InfoContext(ctx context.Context, msg string, attrs ...any)
+	ErrorContext(ctx context.Context, msg string, err error, attrs ...any)
+	WarningContext(ctx context.Context, msg string, attrs ...any)
 	Close()
 }
@@ -0,0 +1,11 @@
+package ports
+
+import (
+	"TUI_playlist_reorder/internal/core/domain"
+	"context"
+)
+
+type ScriptPort interface {
+	ListScripts() ([]domain.Script, error)
+	FilterVideos(ctx context.Context, scriptName string, videos []domain.Video) ([]domain.Video, error)
+}
@@ -0,0 +1,10 @@
+package ports
+
+import "TUI_playlist_reorder/internal/core/domain"
+
+type SmartPlaylistPort interface {
+	SaveSmartPlaylist(definition domain.SmartPlaylist) error
+	ListSmartPlaylists() ([]domain.SmartPlaylist, error)
+	LoadSmartPlaylist(name string) (domain.SmartPlaylist, error)
+	DeleteSmartPlaylist(name string) error
+}
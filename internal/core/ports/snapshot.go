@@ -0,0 +1,9 @@
+package ports
+
+import "TUI_playlist_reorder/internal/core/domain"
+
+type SnapshotPort interface {
+	SaveSnapshot(playlist domain.Playlist) (domain.Snapshot, error)
+	ListSnapshots(playlistID string) ([]domain.Snapshot, error)
+	LoadSnapshot(playlistID, snapshotID string) (domain.Snapshot, error)
+}
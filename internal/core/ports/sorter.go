@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"TUI_playlist_reorder/internal/core/domain"
+	"context"
+)
+
+type SorterPort interface {
+	// Name é o identificador usado como critério, ex: "duration" ou "plugin:shuffle"
+	Name() string
+	// Label é o texto exibido no menu de reordenação
+	Label() string
+	Sort(ctx context.Context, playlist domain.Playlist) (domain.Playlist, error)
+}
@@ -11,5 +11,9 @@ type YoutubePort interface {
 	GetPlaylistByID(playlistID string, ctx context.Context) (domain.Playlist, error)
 	GetPlaylistByURL(playlistURL string, ctx context.Context) (domain.Playlist, error)
 	DeletePlaylist(playlistID string, ctx context.Context) error
-	SavePlaylist(title string, playlist domain.Playlist, ctx context.Context) error
+	SavePlaylist(title string, playlist domain.Playlist, ctx context.Context) (string, error)
+	ReplacePlaylistVideos(playlistID string, videos []domain.Video, ctx context.Context) error
+	ReorderPlaylistVideos(playlistID string, videos []domain.Video, removedItemIDs []string, ctx context.Context) error
+	DeletePlaylistItem(playlistItemID string, ctx context.Context) error
+	GetMyChannel(ctx context.Context) (domain.Channel, error)
 }
@@ -0,0 +1,91 @@
+package usecases
+
+import (
+	"TUI_playlist_reorder/internal/core/domain"
+	"context"
+	"fmt"
+)
+
+func (uc *playlistUseCase) FindDuplicates(ctx context.Context, playlistID string) (domain.Playlist, []domain.DuplicateGroup, error) {
+	uc.log.InfoContext(ctx, "Init Find Duplicates")
+
+	if playlistID == "" {
+		return domain.Playlist{}, nil, domain.TagError(ctx, fmt.Errorf("playlist ID cannot be empty"))
+	}
+
+	playlist, err := uc.service.GetPlaylistByID(playlistID, ctx)
+	if err != nil {
+		uc.log.ErrorContext(ctx, "Failed to get playlist for duplicate detection", err)
+		return domain.Playlist{}, nil, domain.TagError(ctx, fmt.Errorf("error while getting playlist: %w", err))
+	}
+
+	groups := playlist.FindDuplicates()
+
+	uc.log.InfoContext(ctx, "Find Duplicates Completed", "playlist_id", playlistID, "groups", len(groups))
+
+	return playlist, groups, nil
+}
+
+func (uc *playlistUseCase) RemoveDuplicates(ctx context.Context, playlist domain.Playlist, positions []int, inPlace bool, title string) error {
+	uc.log.InfoContext(ctx, "Init Remove Duplicates")
+
+	if len(positions) == 0 {
+		return domain.TagError(ctx, fmt.Errorf("no videos selected for removal"))
+	}
+
+	if !inPlace && title == "" {
+		return domain.TagError(ctx, fmt.Errorf("title cannot be empty when saving a copy"))
+	}
+
+	if inPlace {
+		// guarda o estado anterior para permitir comparar depois da remoção
+		if _, err := uc.snapshots.SaveSnapshot(playlist); err != nil {
+			uc.log.WarningContext(ctx, "Failed to save snapshot before removing duplicates", "playlist_id", playlist.ID, "err", err)
+		}
+	}
+
+	removed := playlist.RemoveAt(positions)
+
+	ctx, err := domain.BeginWrites(ctx)
+	if err != nil {
+		return domain.TagError(ctx, err)
+	}
+
+	if inPlace {
+		for _, video := range removed {
+			if err := uc.service.DeletePlaylistItem(video.ItemID, ctx); err != nil {
+				uc.log.ErrorContext(ctx, "Failed to remove duplicate from playlist", err, "playlist_id", playlist.ID, "video_id", video.ID)
+				return domain.TagError(ctx, fmt.Errorf("error while removing video %s: %w", video.ID, err))
+			}
+		}
+
+		uc.log.InfoContext(ctx, "Removed duplicates in place", "playlist_id", playlist.ID, "removed", len(removed))
+		return nil
+	}
+
+	if newID, err := uc.service.SavePlaylist(title, playlist, ctx); err != nil {
+		uc.log.ErrorContext(ctx, "Failed to save deduplicated playlist", err)
+		uc.discardPartialCopy(ctx, uc.service, newID)
+		return domain.TagError(ctx, fmt.Errorf("error while saving deduplicated playlist: %w", err))
+	}
+
+	uc.log.InfoContext(ctx, "Deduplicated playlist saved successfully")
+
+	return nil
+}
+
+func (uc *playlistUseCase) FindCrossPlaylistDuplicates(ctx context.Context) ([]domain.CrossPlaylistDuplicate, error) {
+	uc.log.InfoContext(ctx, "Init Find Cross Playlist Duplicates")
+
+	playlists, err := uc.service.GetAllPlaylistsFromUser(ctx)
+	if err != nil {
+		uc.log.ErrorContext(ctx, "Failed to get playlists from user", err)
+		return nil, domain.TagError(ctx, fmt.Errorf("error while getting playlists from user: %w", err))
+	}
+
+	report := domain.FindCrossPlaylistDuplicates(playlists)
+
+	uc.log.InfoContext(ctx, "Find Cross Playlist Duplicates Completed", "videos", len(report))
+
+	return report, nil
+}
@@ -0,0 +1,111 @@
+package usecases
+
+import (
+	"TUI_playlist_reorder/internal/core/domain"
+	"context"
+	"fmt"
+	"strings"
+)
+
+// resolvePlaylist aceita tanto o ID da playlist quanto a URL do YouTube.
+func (uc *playlistUseCase) resolvePlaylist(ctx context.Context, ref string) (domain.Playlist, error) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" {
+		return domain.Playlist{}, domain.TagError(ctx, fmt.Errorf("playlist reference cannot be empty"))
+	}
+
+	if strings.Contains(ref, "list=") {
+		return uc.service.GetPlaylistByURL(ref, ctx)
+	}
+
+	return uc.service.GetPlaylistByID(ref, ctx)
+}
+
+func (uc *playlistUseCase) DiffPlaylists(ctx context.Context, refA, refB string) (domain.PlaylistDiff, error) {
+	uc.log.InfoContext(ctx, "Init Diff Playlists")
+
+	a, err := uc.resolvePlaylist(ctx, refA)
+	if err != nil {
+		uc.log.ErrorContext(ctx, "Failed to get first playlist for diff", err)
+		return domain.PlaylistDiff{}, domain.TagError(ctx, fmt.Errorf("error while getting playlist %s: %w", refA, err))
+	}
+
+	b, err := uc.resolvePlaylist(ctx, refB)
+	if err != nil {
+		uc.log.ErrorContext(ctx, "Failed to get second playlist for diff", err)
+		return domain.PlaylistDiff{}, domain.TagError(ctx, fmt.Errorf("error while getting playlist %s: %w", refB, err))
+	}
+
+	diff := domain.DiffPlaylists(a, b)
+
+	uc.log.InfoContext(ctx, "Diff Playlists Completed")
+
+	return diff, nil
+}
+
+// DiffWithSnapshot compara um snapshot local (A) com o estado atual da playlist (B).
+// Se snapshotID for vazio usa o snapshot mais recente.
+func (uc *playlistUseCase) DiffWithSnapshot(ctx context.Context, ref, snapshotID string) (domain.PlaylistDiff, domain.Snapshot, error) {
+	uc.log.InfoContext(ctx, "Init Diff With Snapshot")
+
+	current, err := uc.resolvePlaylist(ctx, ref)
+	if err != nil {
+		uc.log.ErrorContext(ctx, "Failed to get playlist for snapshot diff", err)
+		return domain.PlaylistDiff{}, domain.Snapshot{}, domain.TagError(ctx, fmt.Errorf("error while getting playlist %s: %w", ref, err))
+	}
+
+	var snapshot domain.Snapshot
+	if snapshotID == "" {
+		snapshots, err := uc.snapshots.ListSnapshots(current.ID)
+		if err != nil {
+			uc.log.ErrorContext(ctx, "Failed to list snapshots", err)
+			return domain.PlaylistDiff{}, domain.Snapshot{}, domain.TagError(ctx, fmt.Errorf("error while listing snapshots: %w", err))
+		}
+		if len(snapshots) == 0 {
+			return domain.PlaylistDiff{}, domain.Snapshot{}, domain.TagError(ctx, fmt.Errorf("no snapshots found for playlist %s", current.ID))
+		}
+		snapshot = snapshots[0]
+	} else {
+		snapshot, err = uc.snapshots.LoadSnapshot(current.ID, snapshotID)
+		if err != nil {
+			uc.log.ErrorContext(ctx, "Failed to load snapshot", err)
+			return domain.PlaylistDiff{}, domain.Snapshot{}, domain.TagError(ctx, fmt.Errorf("error while loading snapshot: %w", err))
+		}
+	}
+
+	diff := domain.DiffPlaylists(snapshot.Playlist, current)
+
+	uc.log.InfoContext(ctx, "Diff With Snapshot Completed")
+
+	return diff, snapshot, nil
+}
+
+func (uc *playlistUseCase) TakeSnapshot(ctx context.Context, ref string) (domain.Snapshot, error) {
+	uc.log.InfoContext(ctx, "Init Take Snapshot")
+
+	playlist, err := uc.resolvePlaylist(ctx, ref)
+	if err != nil {
+		uc.log.ErrorContext(ctx, "Failed to get playlist for snapshot", err)
+		return domain.Snapshot{}, domain.TagError(ctx, fmt.Errorf("error while getting playlist %s: %w", ref, err))
+	}
+
+	snapshot, err := uc.snapshots.SaveSnapshot(playlist)
+	if err != nil {
+		uc.log.ErrorContext(ctx, "Failed to save snapshot", err)
+		return domain.Snapshot{}, domain.TagError(ctx, fmt.Errorf("error while saving snapshot: %w", err))
+	}
+
+	uc.log.InfoContext(ctx, "Snapshot saved", "snapshot_id", snapshot.ID, "playlist_id", playlist.ID)
+
+	return snapshot, nil
+}
+
+func (uc *playlistUseCase) ListSnapshots(playlistID string) ([]domain.Snapshot, error) {
+	snapshots, err := uc.snapshots.ListSnapshots(playlistID)
+	if err != nil {
+		uc.log.Error("Failed to list snapshots", err)
+		return nil, fmt.Errorf("error while listing snapshots: %w", err)
+	}
+
+	return snapshots, nil
+}
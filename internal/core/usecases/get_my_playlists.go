@@ -7,15 +7,15 @@ import (
 )
 
 func (uc *playlistUseCase) GetMinePlaylists(ctx context.Context) ([]domain.Playlist, error) {
-	uc.log.Info("Init Get my playlists")
+	uc.log.InfoContext(ctx, "Init Get my playlists")
 
 	playlists, err := uc.service.GetPlaylistWithoutVideos(ctx)
 	if err != nil {
-		uc.log.Error("Failed to get playlists from user", err)
-		return nil, fmt.Errorf("error while getting playlists from user: %w", err)
+		uc.log.ErrorContext(ctx, "Failed to get playlists from user", err)
+		return nil, domain.TagError(ctx, fmt.Errorf("error while getting playlists from user: %w", err))
 	}
 
-	defer uc.log.Info("Get my playlists done")
+	defer uc.log.InfoContext(ctx, "Get my playlists done")
 
 	return playlists, nil
 }
@@ -0,0 +1,26 @@
+package usecases
+
+import (
+	"TUI_playlist_reorder/internal/core/domain"
+	"context"
+	"fmt"
+)
+
+// GetPlaylistByID carrega a playlist com todos os vídeos (a lista de playlists vem sem eles).
+func (uc *playlistUseCase) GetPlaylistByID(ctx context.Context, playlistID string) (domain.Playlist, error) {
+	uc.log.InfoContext(ctx, "Init Get Playlist By ID", "playlist_id", playlistID)
+
+	if playlistID == "" {
+		return domain.Playlist{}, domain.TagError(ctx, fmt.Errorf("playlist ID cannot be empty"))
+	}
+
+	playlist, err := uc.service.GetPlaylistByID(playlistID, ctx)
+	if err != nil {
+		uc.log.ErrorContext(ctx, "Failed to get playlist by ID", err, "playlist_id", playlistID)
+		return domain.Playlist{}, domain.TagError(ctx, fmt.Errorf("error while getting playlist: %w", err))
+	}
+
+	uc.log.InfoContext(ctx, "Get Playlist By ID Completed", "playlist_id", playlistID, "videos", len(playlist.Videos))
+
+	return playlist, nil
+}
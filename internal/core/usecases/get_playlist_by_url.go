@@ -7,21 +7,21 @@ import (
 )
 
 func (uc *playlistUseCase) GetPlaylistByURL(ctx context.Context, url string) (domain.Playlist, error) {
-	uc.log.Info("Init Get Playlist By URL")
+	uc.log.InfoContext(ctx, "Init Get Playlist By URL")
 
 	// Validate the URL
 	if url == "" {
-		return domain.Playlist{}, fmt.Errorf("playlist URL cannot be empty")
+		return domain.Playlist{}, domain.TagError(ctx, fmt.Errorf("playlist URL cannot be empty"))
 	}
 
 	// Call the service to get the playlist by URL
 	playlist, err := uc.service.GetPlaylistByURL(url, ctx)
 	if err != nil {
-		uc.log.Error("Failed to get playlist by URL", err)
+		uc.log.ErrorContext(ctx, "Failed to get playlist by URL", err)
 		return domain.Playlist{}, err
 	}
 
-	uc.log.Info("Get Playlist By URL Completed")
+	uc.log.InfoContext(ctx, "Get Playlist By URL Completed")
 
 	return playlist, nil
 }
@@ -7,19 +7,61 @@ import (
 )
 
 type playlistUseCase struct {
-	service ports.YoutubePort
-	log     ports.LoggerPort
+	service        ports.YoutubePort
+	snapshots      ports.SnapshotPort
+	smartPlaylists ports.SmartPlaylistPort
+	scripts        ports.ScriptPort
+	sorters        []ports.SorterPort
+	accounts       ports.AccountPort
+	profile        string
+	log            ports.LoggerPort
 }
 
 type PlaylistUseCase interface {
 	GetMinePlaylists(ctx context.Context) ([]domain.Playlist, error)
-	ReorderPlaylist(ctx context.Context, playlistID, criteria, title string) error
+	ReorderPlaylist(ctx context.Context, playlistID, criteria string, inPlace bool, title string) error
+	SaveOrderedPlaylist(ctx context.Context, playlist domain.Playlist, removedItemIDs []string, inPlace bool, title string) error
+	ListSorters() []SorterInfo
+	SortPlaylist(ctx context.Context, playlist domain.Playlist, criteria string) (domain.Playlist, error)
+	ListScripts() ([]domain.Script, error)
 	GetPlaylistByURL(ctx context.Context, url string) (domain.Playlist, error)
+	GetPlaylistByID(ctx context.Context, playlistID string) (domain.Playlist, error)
+	FindDuplicates(ctx context.Context, playlistID string) (domain.Playlist, []domain.DuplicateGroup, error)
+	RemoveDuplicates(ctx context.Context, playlist domain.Playlist, positions []int, inPlace bool, title string) error
+	FindCrossPlaylistDuplicates(ctx context.Context) ([]domain.CrossPlaylistDuplicate, error)
+	DiffPlaylists(ctx context.Context, refA, refB string) (domain.PlaylistDiff, error)
+	DiffWithSnapshot(ctx context.Context, ref, snapshotID string) (domain.PlaylistDiff, domain.Snapshot, error)
+	TakeSnapshot(ctx context.Context, ref string) (domain.Snapshot, error)
+	ListSnapshots(playlistID string) ([]domain.Snapshot, error)
+	SaveSmartPlaylist(definition domain.SmartPlaylist) error
+	ListSmartPlaylists() ([]domain.SmartPlaylist, error)
+	DeleteSmartPlaylist(name string) error
+	PreviewSmartPlaylist(ctx context.Context, definition domain.SmartPlaylist) (domain.Playlist, error)
+	BuildSmartPlaylist(ctx context.Context, name string) (domain.Playlist, error)
+	ActiveProfile() string
+	ListProfiles(ctx context.Context) ([]domain.Profile, error)
+	GetMyChannel(ctx context.Context) (domain.Channel, error)
+	CopyPlaylistToProfile(ctx context.Context, ref, profile, title string) (domain.Playlist, error)
 }
 
-func NewPlaylistUseCase(service ports.YoutubePort, logger ports.LoggerPort) PlaylistUseCase {
+func NewPlaylistUseCase(
+	service ports.YoutubePort,
+	snapshots ports.SnapshotPort,
+	smartPlaylists ports.SmartPlaylistPort,
+	scripts ports.ScriptPort,
+	extraSorters []ports.SorterPort,
+	accounts ports.AccountPort,
+	profile string,
+	logger ports.LoggerPort,
+) PlaylistUseCase {
 	return &playlistUseCase{
-		service: service,
-		log:     logger,
+		service:        service,
+		snapshots:      snapshots,
+		smartPlaylists: smartPlaylists,
+		scripts:        scripts,
+		sorters:        append(builtinSorters(), extraSorters...),
+		accounts:       accounts,
+		profile:        profile,
+		log:            logger,
 	}
 }
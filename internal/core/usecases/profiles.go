@@ -0,0 +1,120 @@
+package usecases
+
+import (
+	"TUI_playlist_reorder/internal/core/domain"
+	"context"
+	"fmt"
+	"strings"
+)
+
+// ActiveProfile é o perfil cujo token é usado pelas demais operações deste use case.
+func (uc *playlistUseCase) ActiveProfile() string {
+	return uc.profile
+}
+
+// ListProfiles retorna todos os perfis; para os que têm token, consulta o canal no YouTube.
+func (uc *playlistUseCase) ListProfiles(ctx context.Context) ([]domain.Profile, error) {
+	uc.log.InfoContext(ctx, "Init List Profiles")
+
+	names, err := uc.accounts.ListProfiles()
+	if err != nil {
+		uc.log.ErrorContext(ctx, "Failed to list profiles", err)
+		return nil, domain.TagError(ctx, fmt.Errorf("error while listing profiles: %w", err))
+	}
+
+	// o perfil ativo aparece mesmo antes do primeiro login
+	found := false
+	for _, name := range names {
+		if name == uc.profile {
+			found = true
+			break
+		}
+	}
+	if !found {
+		names = append(names, uc.profile)
+	}
+
+	profiles := make([]domain.Profile, 0, len(names))
+	for _, name := range names {
+		profile := domain.Profile{
+			Name:     name,
+			Active:   name == uc.profile,
+			LoggedIn: uc.accounts.HasToken(name),
+		}
+
+		if profile.LoggedIn {
+			if channel, err := uc.channelFor(ctx, name); err != nil {
+				uc.log.WarningContext(ctx, "Could not fetch channel for profile", "profile", name, "err", err)
+			} else {
+				profile.Channel = &channel
+			}
+		}
+
+		profiles = append(profiles, profile)
+	}
+
+	uc.log.InfoContext(ctx, "List Profiles Completed")
+
+	return profiles, nil
+}
+
+func (uc *playlistUseCase) GetMyChannel(ctx context.Context) (domain.Channel, error) {
+	return uc.service.GetMyChannel(ctx)
+}
+
+func (uc *playlistUseCase) channelFor(ctx context.Context, profile string) (domain.Channel, error) {
+	service, err := uc.accounts.YoutubeFor(profile)
+	if err != nil {
+		return domain.Channel{}, err
+	}
+	return service.GetMyChannel(ctx)
+}
+
+// CopyPlaylistToProfile lê a playlist com a conta ativa e cria uma cópia na conta do perfil informado.
+func (uc *playlistUseCase) CopyPlaylistToProfile(ctx context.Context, ref, profile, title string) (domain.Playlist, error) {
+	uc.log.InfoContext(ctx, "Init Copy Playlist To Profile")
+
+	profile = strings.TrimSpace(profile)
+	if profile == "" {
+		return domain.Playlist{}, domain.TagError(ctx, fmt.Errorf("target profile cannot be empty"))
+	}
+	if !uc.accounts.HasToken(profile) {
+		return domain.Playlist{}, domain.TagError(ctx, fmt.Errorf("profile %s is not logged in", profile))
+	}
+
+	source, err := uc.resolvePlaylist(ctx, ref)
+	if err != nil {
+		uc.log.ErrorContext(ctx, "Failed to get playlist to copy", err)
+		return domain.Playlist{}, domain.TagError(ctx, fmt.Errorf("error while getting playlist %s: %w", ref, err))
+	}
+
+	target, err := uc.accounts.YoutubeFor(profile)
+	if err != nil {
+		uc.log.ErrorContext(ctx, "Failed to get target profile service", err)
+		return domain.Playlist{}, domain.TagError(ctx, fmt.Errorf("error while opening profile %s: %w", profile, err))
+	}
+
+	if strings.TrimSpace(title) == "" {
+		title = source.Title
+	}
+
+	ctx, err = domain.BeginWrites(ctx)
+	if err != nil {
+		return domain.Playlist{}, domain.TagError(ctx, err)
+	}
+
+	newID, err := target.SavePlaylist(title, source, ctx)
+	if err != nil {
+		uc.log.ErrorContext(ctx, "Failed to save playlist copy", err)
+		uc.discardPartialCopy(ctx, target, newID)
+		return domain.Playlist{}, domain.TagError(ctx, fmt.Errorf("error while saving playlist in profile %s: %w", profile, err))
+	}
+
+	copied := source
+	copied.ID = newID
+	copied.Title = title
+
+	uc.log.InfoContext(ctx, "Copy Playlist To Profile Completed", "playlist_id", source.ID, "new_playlist_id", newID, "profile", profile)
+
+	return copied, nil
+}
@@ -1,47 +1,113 @@
 package usecases
 
 import (
+	"TUI_playlist_reorder/internal/core/domain"
+	"TUI_playlist_reorder/internal/core/ports"
 	"context"
 	"fmt"
+	"time"
 )
 
-func (uc *playlistUseCase) ReorderPlaylist(ctx context.Context, playlistID, criteria, title string) error {
-	uc.log.Info("Init Reorder Playlist")
+func (uc *playlistUseCase) ReorderPlaylist(ctx context.Context, playlistID, criteria string, inPlace bool, title string) error {
+	start := time.Now()
+	uc.log.InfoContext(ctx, "Init Reorder Playlist", "playlist_id", playlistID, "criteria", criteria)
 
 	// Validate the playlist ID
 	if playlistID == "" {
-		return fmt.Errorf("playlist ID cannot be empty")
+		return domain.TagError(ctx, fmt.Errorf("playlist ID cannot be empty"))
 	}
 
 	// Call the service to reorder the playlist
 	playlist, err := uc.service.GetPlaylistByID(playlistID, ctx)
 	if err != nil {
-		uc.log.Error("Failed to reorder playlist", err)
-		return fmt.Errorf("error while reordering playlist: %w", err)
+		uc.log.ErrorContext(ctx, "Failed to reorder playlist", err, "playlist_id", playlistID)
+		return domain.TagError(ctx, fmt.Errorf("error while reordering playlist: %w", err))
 	}
 
 	// Reorder the playlist based on the criteria
-	switch criteria {
-	case "name":
-		playlist.SortByName()
-	case "duration":
-		playlist.SortByDuration()
-	case "publish":
-		playlist.SortByPublish()
-	case "language":
-		playlist.SortByLanguage()
+	playlist, err = uc.SortPlaylist(ctx, playlist, criteria)
+	if err != nil {
+		return err
 	}
 
-	uc.log.Info("Reorder Playlist Completed")
+	uc.log.InfoContext(ctx, "Reorder Playlist Completed", "playlist_id", playlistID, "criteria", criteria,
+		"videos", len(playlist.Videos), "duration_ms", time.Since(start).Milliseconds())
+
+	return uc.SaveOrderedPlaylist(ctx, playlist, nil, inPlace, title)
+}
+
+// SaveOrderedPlaylist grava a playlist na ordem em que está: na própria playlist
+// (movendo só os itens fora do lugar, com um snapshot antes) ou como uma cópia com o título informado.
+// É o caminho de gravação tanto das ordenações automáticas quanto da manual. Na própria
+// playlist só saem os itens de removedItemIDs; os que não vieram em playlist.Videos ficam.
+func (uc *playlistUseCase) SaveOrderedPlaylist(ctx context.Context, playlist domain.Playlist, removedItemIDs []string, inPlace bool, title string) error {
+	start := time.Now()
+	uc.log.InfoContext(ctx, "Init Save Ordered Playlist", "playlist_id", playlist.ID, "in_place", inPlace, "removed", len(removedItemIDs))
+
+	if inPlace {
+		if playlist.ID == "" {
+			return domain.TagError(ctx, fmt.Errorf("playlist ID cannot be empty"))
+		}
+
+		// guarda a ordem atual no YouTube para permitir comparar ou voltar depois
+		var snapshot domain.Snapshot
+		if current, err := uc.service.GetPlaylistByID(playlist.ID, ctx); err != nil {
+			uc.log.WarningContext(ctx, "Failed to read playlist for snapshot before reordering in place", "playlist_id", playlist.ID, "err", err)
+		} else if snapshot, err = uc.snapshots.SaveSnapshot(current); err != nil {
+			uc.log.WarningContext(ctx, "Failed to save snapshot before reordering in place", "playlist_id", playlist.ID, "err", err)
+		}
+
+		ctx, err := domain.BeginWrites(ctx)
+		if err != nil {
+			return domain.TagError(ctx, err)
+		}
+		if err := uc.service.ReorderPlaylistVideos(playlist.ID, playlist.Videos, removedItemIDs, ctx); err != nil {
+			// interrompida no meio, a playlist fica com parte das escritas; o erro (que vai
+			// para o diário) diz quantas e qual snapshot guarda a ordem anterior
+			uc.log.ErrorContext(ctx, "Failed to reorder playlist in place", err, "playlist_id", playlist.ID, "snapshot_id", snapshot.ID)
+			if snapshot.ID != "" {
+				return domain.TagError(ctx, fmt.Errorf("error while reordering playlist in place (order before saved in snapshot %s): %w", snapshot.ID, err))
+			}
+			return domain.TagError(ctx, fmt.Errorf("error while reordering playlist in place: %w", err))
+		}
+
+		uc.log.InfoContext(ctx, "Reordered playlist saved in place", "playlist_id", playlist.ID, "duration_ms", time.Since(start).Milliseconds())
+		return nil
+	}
+
+	if title == "" {
+		return domain.TagError(ctx, fmt.Errorf("title cannot be empty when saving a copy"))
+	}
+
+	ctx, err := domain.BeginWrites(ctx)
+	if err != nil {
+		return domain.TagError(ctx, err)
+	}
 
 	// Save the reordered playlist
-	err = uc.service.SavePlaylist(title, playlist, ctx)
+	newID, err := uc.service.SavePlaylist(title, playlist, ctx)
 	if err != nil {
-		uc.log.Error("Failed to save reordered playlist", err)
-		return fmt.Errorf("error while saving reordered playlist: %w", err)
+		uc.log.ErrorContext(ctx, "Failed to save reordered playlist", err, "playlist_id", playlist.ID)
+		uc.discardPartialCopy(ctx, uc.service, newID)
+		return domain.TagError(ctx, fmt.Errorf("error while saving reordered playlist: %w", err))
 	}
 
-	uc.log.Info("Reordered playlist saved successfully")
+	uc.log.InfoContext(ctx, "Reordered playlist saved successfully", "playlist_id", playlist.ID, "new_playlist_id", newID, "duration_ms", time.Since(start).Milliseconds())
 
 	return nil
 }
+
+// discardPartialCopy apaga a playlist criada por uma cópia que falhou no meio, para não
+// deixar para trás uma playlist incompleta que o usuário não pediu. Roda também quando a
+// cópia parou por cancelamento ou prazo, por isso ignora os do contexto.
+func (uc *playlistUseCase) discardPartialCopy(ctx context.Context, service ports.YoutubePort, playlistID string) {
+	if playlistID == "" {
+		return
+	}
+	ctx = context.WithoutCancel(ctx)
+	if err := service.DeletePlaylist(playlistID, ctx); err != nil {
+		uc.log.WarningContext(ctx, "Failed to delete partial playlist copy", "new_playlist_id", playlistID, "err", err)
+		return
+	}
+	uc.log.InfoContext(ctx, "Partial playlist copy deleted", "new_playlist_id", playlistID)
+}
@@ -0,0 +1,136 @@
+package usecases
+
+import (
+	"TUI_playlist_reorder/internal/core/domain"
+	"context"
+	"fmt"
+	"time"
+)
+
+func (uc *playlistUseCase) SaveSmartPlaylist(definition domain.SmartPlaylist) error {
+	if err := definition.Validate(); err != nil {
+		return err
+	}
+
+	if err := uc.smartPlaylists.SaveSmartPlaylist(definition); err != nil {
+		uc.log.Error("Failed to save smart playlist", err)
+		return fmt.Errorf("error while saving smart playlist: %w", err)
+	}
+
+	uc.log.Info("Smart playlist saved", "smart_playlist", definition.Name)
+
+	return nil
+}
+
+func (uc *playlistUseCase) ListSmartPlaylists() ([]domain.SmartPlaylist, error) {
+	definitions, err := uc.smartPlaylists.ListSmartPlaylists()
+	if err != nil {
+		uc.log.Error("Failed to list smart playlists", err)
+		return nil, fmt.Errorf("error while listing smart playlists: %w", err)
+	}
+
+	return definitions, nil
+}
+
+func (uc *playlistUseCase) DeleteSmartPlaylist(name string) error {
+	if err := uc.smartPlaylists.DeleteSmartPlaylist(name); err != nil {
+		uc.log.Error("Failed to delete smart playlist", err)
+		return fmt.Errorf("error while deleting smart playlist: %w", err)
+	}
+
+	uc.log.Info("Smart playlist deleted", "smart_playlist", name)
+
+	return nil
+}
+
+// PreviewSmartPlaylist monta a playlist a partir das origens sem salvar nada no YouTube.
+func (uc *playlistUseCase) PreviewSmartPlaylist(ctx context.Context, definition domain.SmartPlaylist) (domain.Playlist, error) {
+	uc.log.InfoContext(ctx, "Init Preview Smart Playlist", "smart_playlist", definition.Name)
+
+	if err := definition.Validate(); err != nil {
+		return domain.Playlist{}, err
+	}
+
+	sources := make([]domain.Playlist, 0, len(definition.Sources))
+	for _, ref := range definition.Sources {
+		source, err := uc.resolvePlaylist(ctx, ref)
+		if err != nil {
+			uc.log.ErrorContext(ctx, "Failed to get smart playlist source", err)
+			return domain.Playlist{}, domain.TagError(ctx, fmt.Errorf("error while getting source playlist %s: %w", ref, err))
+		}
+		sources = append(sources, source)
+	}
+
+	playlist, err := definition.Build(sources)
+	if err != nil {
+		return domain.Playlist{}, err
+	}
+
+	// o script roda depois da ordenação; como só remove vídeos, a ordem é preservada
+	if definition.FilterScript != "" {
+		playlist.Videos, err = uc.scripts.FilterVideos(ctx, definition.FilterScript, playlist.Videos)
+		if err != nil {
+			uc.log.ErrorContext(ctx, "Failed to apply filter script", err)
+			return domain.Playlist{}, domain.TagError(ctx, fmt.Errorf("error while applying filter script %s: %w", definition.FilterScript, err))
+		}
+	}
+
+	uc.log.InfoContext(ctx, "Preview Smart Playlist Completed", "smart_playlist", definition.Name, "videos", len(playlist.Videos))
+
+	return playlist, nil
+}
+
+// BuildSmartPlaylist gera a playlist e salva no YouTube. Na primeira vez cria uma
+// playlist nova; nas seguintes substitui o conteúdo da playlist gerada anteriormente.
+func (uc *playlistUseCase) BuildSmartPlaylist(ctx context.Context, name string) (domain.Playlist, error) {
+	uc.log.InfoContext(ctx, "Init Build Smart Playlist", "smart_playlist", name)
+
+	definition, err := uc.smartPlaylists.LoadSmartPlaylist(name)
+	if err != nil {
+		uc.log.ErrorContext(ctx, "Failed to load smart playlist", err)
+		return domain.Playlist{}, domain.TagError(ctx, fmt.Errorf("error while loading smart playlist: %w", err))
+	}
+
+	playlist, err := uc.PreviewSmartPlaylist(ctx, definition)
+	if err != nil {
+		return domain.Playlist{}, err
+	}
+
+	ctx, err = domain.BeginWrites(ctx)
+	if err != nil {
+		return domain.Playlist{}, domain.TagError(ctx, err)
+	}
+
+	if definition.TargetPlaylistID == "" {
+		playlistID, err := uc.service.SavePlaylist(playlist.Title, playlist, ctx)
+		if err != nil {
+			uc.log.ErrorContext(ctx, "Failed to save smart playlist", err, "playlist_id", playlistID)
+			// a playlist pode ter sido criada antes da falha; guardar o ID faz a próxima
+			// geração substituir o conteúdo dela em vez de criar outra
+			if playlistID != "" {
+				definition.TargetPlaylistID = playlistID
+				if saveErr := uc.smartPlaylists.SaveSmartPlaylist(definition); saveErr != nil {
+					uc.log.ErrorContext(ctx, "Failed to update smart playlist definition", saveErr)
+				}
+			}
+			return domain.Playlist{}, domain.TagError(ctx, fmt.Errorf("error while saving smart playlist: %w", err))
+		}
+		definition.TargetPlaylistID = playlistID
+		playlist.ID = playlistID
+	} else {
+		if err := uc.service.ReplacePlaylistVideos(definition.TargetPlaylistID, playlist.Videos, ctx); err != nil {
+			uc.log.ErrorContext(ctx, "Failed to rebuild smart playlist", err)
+			return domain.Playlist{}, domain.TagError(ctx, fmt.Errorf("error while rebuilding smart playlist: %w", err))
+		}
+	}
+
+	definition.LastBuiltAt = time.Now()
+	if err := uc.smartPlaylists.SaveSmartPlaylist(definition); err != nil {
+		uc.log.ErrorContext(ctx, "Failed to update smart playlist definition", err)
+		return domain.Playlist{}, domain.TagError(ctx, fmt.Errorf("error while updating smart playlist: %w", err))
+	}
+
+	uc.log.InfoContext(ctx, "Build Smart Playlist Completed", "smart_playlist", name, "playlist_id", definition.TargetPlaylistID, "videos", len(playlist.Videos))
+
+	return playlist, nil
+}
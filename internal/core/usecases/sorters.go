@@ -0,0 +1,72 @@
+package usecases
+
+import (
+	"TUI_playlist_reorder/internal/core/domain"
+	"TUI_playlist_reorder/internal/core/ports"
+	"context"
+	"fmt"
+)
+
+type builtinSorter struct {
+	name  string
+	label string
+	sort  func(p *domain.Playlist)
+}
+
+func (s builtinSorter) Name() string  { return s.name }
+func (s builtinSorter) Label() string { return s.label }
+
+func (s builtinSorter) Sort(_ context.Context, playlist domain.Playlist) (domain.Playlist, error) {
+	playlist.Videos = append([]domain.Video(nil), playlist.Videos...)
+	s.sort(&playlist)
+	return playlist, nil
+}
+
+func builtinSorters() []ports.SorterPort {
+	return []ports.SorterPort{
+		builtinSorter{name: "name", label: "Ordenar por Nome (A-Z)", sort: (*domain.Playlist).SortByName},
+		builtinSorter{name: "duration", label: "Ordenar por Duração (Menor-Maior)", sort: (*domain.Playlist).SortByDuration},
+		builtinSorter{name: "language", label: "Ordenar por Idioma (A-Z)", sort: (*domain.Playlist).SortByLanguage},
+		builtinSorter{name: "publish", label: "Ordenar por Data de Publicação (Mais Antigo-Mais Novo)", sort: (*domain.Playlist).SortByPublish},
+	}
+}
+
+type SorterInfo struct {
+	Name  string
+	Label string
+}
+
+func (uc *playlistUseCase) ListSorters() []SorterInfo {
+	infos := make([]SorterInfo, 0, len(uc.sorters))
+	for _, sorter := range uc.sorters {
+		infos = append(infos, SorterInfo{Name: sorter.Name(), Label: sorter.Label()})
+	}
+	return infos
+}
+
+func (uc *playlistUseCase) SortPlaylist(ctx context.Context, playlist domain.Playlist, criteria string) (domain.Playlist, error) {
+	for _, sorter := range uc.sorters {
+		if sorter.Name() != criteria {
+			continue
+		}
+
+		sorted, err := sorter.Sort(ctx, playlist)
+		if err != nil {
+			uc.log.ErrorContext(ctx, "Sorter failed", err, "criteria", criteria)
+			return domain.Playlist{}, domain.TagError(ctx, fmt.Errorf("error while sorting playlist by %s: %w", criteria, err))
+		}
+		return sorted, nil
+	}
+
+	return domain.Playlist{}, domain.TagError(ctx, fmt.Errorf("unknown sort criteria: %s", criteria))
+}
+
+func (uc *playlistUseCase) ListScripts() ([]domain.Script, error) {
+	scripts, err := uc.scripts.ListScripts()
+	if err != nil {
+		uc.log.Error("Failed to list scripts", err)
+		return nil, fmt.Errorf("error while listing scripts: %w", err)
+	}
+
+	return scripts, nil
+}
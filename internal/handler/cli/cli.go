@@ -0,0 +1,135 @@
+package cli
+
+import (
+	"TUI_playlist_reorder/infrastructure/auth"
+	"TUI_playlist_reorder/infrastructure/config"
+	"TUI_playlist_reorder/infrastructure/logger"
+	"TUI_playlist_reorder/internal/core/domain"
+	"TUI_playlist_reorder/internal/core/ports"
+	"TUI_playlist_reorder/internal/core/usecases"
+	"context"
+	"fmt"
+	"io"
+)
+
+// AuthServiceFactory cria o serviço de autenticação de um perfil; só comandos que mexem no
+// login (logout) precisam dele, então o arquivo de credenciais não é exigido pelos demais.
+type AuthServiceFactory func(profile string) (auth.AuthenticationService, error)
+
+type CLI struct {
+	playlistUseCase usecases.PlaylistUseCase
+	newAuthService  AuthServiceFactory
+	config          config.Config
+	logger          logger.Logger
+	journal         ports.JobJournalPort
+	out             io.Writer
+}
+
+func NewCLI(playlistUC usecases.PlaylistUseCase, newAuthService AuthServiceFactory, cfg config.Config, log logger.Logger, journal ports.JobJournalPort, out io.Writer) *CLI {
+	return &CLI{
+		playlistUseCase: playlistUC,
+		newAuthService:  newAuthService,
+		config:          cfg,
+		logger:          log,
+		journal:         journal,
+		out:             out,
+	}
+}
+
+// Run executa o subcomando informado em args (sem o nome do binário).
+func (c *CLI) Run(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		c.usage()
+		return nil
+	}
+
+	// cada execução é uma operação; o ID aparece nos logs e nos erros do comando
+	ctx = domain.StartOperation(ctx, args[0])
+	c.logger.InfoContext(ctx, "CLI: executando comando", "command", args[0])
+
+	if remoteCommands[args[0]] {
+		return c.runJob(ctx, func(ctx context.Context) error {
+			return c.dispatch(ctx, args)
+		})
+	}
+	return c.dispatch(ctx, args)
+}
+
+func (c *CLI) dispatch(ctx context.Context, args []string) error {
+	switch args[0] {
+	case "diff":
+		return c.runDiff(ctx, args[1:])
+	case "snapshot":
+		return c.runSnapshot(ctx, args[1:])
+	case "snapshots":
+		return c.runSnapshots(ctx, args[1:])
+	case "smart":
+		return c.runSmart(ctx, args[1:])
+	case "scripts":
+		return c.runScripts()
+	case "profiles":
+		return c.runProfiles(ctx)
+	case "copy":
+		return c.runCopy(ctx, args[1:])
+	case "logout":
+		return c.runLogout(ctx)
+	case "logs":
+		return c.runLogs(args[1:])
+	case "jobs":
+		return c.runJobs(args[1:])
+	case "migrate-tokens":
+		return c.runMigrateTokens()
+	case "init":
+		return c.runInit(args[1:])
+	case "help", "-h", "--help":
+		c.usage()
+		return nil
+	default:
+		c.usage()
+		return fmt.Errorf("comando desconhecido: %s", args[0])
+	}
+}
+
+func (c *CLI) usage() {
+	Usage(c.out)
+}
+
+// Usage escreve a ajuda da linha de comando; main também a usa para -h/--help antes do
+// comando, que não chegam à CLI.
+func Usage(out io.Writer) {
+	fmt.Fprintln(out, `Uso: reorder-playlist [opções] [comando] [argumentos]
+
+Sem comando abre a interface interativa (TUI).
+
+Opções (antes do comando; sobrescrevem o arquivo de configuração e as variáveis REORDER_PLAYLIST_*):
+  --config ARQUIVO              arquivo de configuração (padrão: config.toml)
+  --profile NOME                perfil (conta Google ou canal de marca) usado pelo comando
+  --client-secret, --token-file, --token-backend, --callback-address, --log-dir, --log-level, --default-sort,
+  --privacy, --title-template, --quota-budget, --api-timeout-seconds, --job-timeout-minutes,
+  --job-journal-file, --snapshots-dir, --smart-playlists-file, --sorter-plugins-dir, --scripts-dir
+
+Comandos:
+  diff A B                      compara duas playlists (ID ou URL)
+  diff --snapshot [--id ID] A   compara a playlist com um snapshot local (padrão: o mais recente)
+  snapshot A                    salva um snapshot local da playlist
+  snapshots A                   lista os snapshots locais da playlist
+  smart add --name N --source A [--source B] [--title T] [--filter F] [--filter-script S] [--sort S]
+                                salva a definição de uma smart playlist
+  smart list                    lista as smart playlists definidas
+  smart preview N               mostra os vídeos que a smart playlist teria
+  smart build N                 gera (ou regera) a smart playlist no YouTube
+  smart rm N                    remove a definição
+  scripts                       lista os scripts de ordenação/filtro da biblioteca local
+  profiles                      lista os perfis e o canal de cada um (* = ativo)
+  copy --to PERFIL [--title T] A
+                                lê a playlist com o perfil ativo e cria uma cópia no perfil PERFIL
+  logout                        revoga o token do perfil ativo no Google e apaga o arquivo local
+  logs [--errors] [--last N]    lista as operações registradas nos logs (busca, reordenação, login...)
+  logs ID                       mostra as linhas de uma operação, do TUI/CLI até o provider
+  jobs [--last N]               lista as buscas e gravações do diário de operações (concluídas,
+                                com erro, canceladas ou com prazo esgotado)
+  migrate-tokens                cifra os tokens em texto puro de todos os perfis (senha de
+                                REORDER_PLAYLIST_TOKEN_PASSPHRASE ou pedida no terminal)
+  init [--force] [ARQUIVO]      grava um arquivo de configuração comentado com os valores padrão
+  help                          mostra esta ajuda`)
+}
@@ -0,0 +1,119 @@
+package cli
+
+import (
+	"TUI_playlist_reorder/internal/core/domain"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+)
+
+func (c *CLI) runDiff(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("diff", flag.ContinueOnError)
+	fs.SetOutput(c.out)
+	useSnapshot := fs.Bool("snapshot", false, "compara a playlist com um snapshot local")
+	snapshotID := fs.String("id", "", "ID do snapshot (padrão: o mais recente)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *useSnapshot || *snapshotID != "" {
+		if fs.NArg() != 1 {
+			return fmt.Errorf("uso: diff --snapshot [--id ID] <playlist>")
+		}
+
+		diff, snapshot, err := c.playlistUseCase.DiffWithSnapshot(ctx, fs.Arg(0), *snapshotID)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(c.out, "A: snapshot %s (%s)\n", snapshot.ID, snapshot.TakenAt.Local().Format(time.DateTime))
+		fmt.Fprintf(c.out, "B: %s (%s) — estado atual\n\n", diff.B.Title, diff.B.ID)
+		printDiff(c.out, diff)
+		return nil
+	}
+
+	if fs.NArg() != 2 {
+		return fmt.Errorf("uso: diff <playlist A> <playlist B>")
+	}
+
+	diff, err := c.playlistUseCase.DiffPlaylists(ctx, fs.Arg(0), fs.Arg(1))
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(c.out, "A: %s (%s)\n", diff.A.Title, diff.A.ID)
+	fmt.Fprintf(c.out, "B: %s (%s)\n\n", diff.B.Title, diff.B.ID)
+	printDiff(c.out, diff)
+	return nil
+}
+
+func printDiff(out io.Writer, diff domain.PlaylistDiff) {
+	fmt.Fprintf(out, "Somente em A (%d):\n", len(diff.OnlyInA))
+	for _, video := range diff.OnlyInA {
+		fmt.Fprintf(out, "  - %s — %s\n", video.Title, video.Artist)
+	}
+
+	fmt.Fprintf(out, "\nSomente em B (%d):\n", len(diff.OnlyInB))
+	for _, video := range diff.OnlyInB {
+		fmt.Fprintf(out, "  + %s — %s\n", video.Title, video.Artist)
+	}
+
+	fmt.Fprintf(out, "\nEm ambas (%d, %d mudaram de posição):\n", len(diff.InBoth), diff.MovedCount())
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	for _, entry := range diff.InBoth {
+		fmt.Fprintf(w, "  #%d → #%d\t%s\t%s\n", entry.PositionA+1, entry.PositionB+1, formatMove(entry.Moved()), entry.Video.Title)
+	}
+	w.Flush()
+}
+
+// formatMove descreve o deslocamento relativo de um vídeo entre duas versões da playlist.
+func formatMove(moved int) string {
+	switch {
+	case moved < 0:
+		return fmt.Sprintf("↑%d", -moved)
+	case moved > 0:
+		return fmt.Sprintf("↓%d", moved)
+	default:
+		return "="
+	}
+}
+
+func (c *CLI) runSnapshot(ctx context.Context, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("uso: snapshot <playlist>")
+	}
+
+	snapshot, err := c.playlistUseCase.TakeSnapshot(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(c.out, "Snapshot %s salvo para \"%s\" (%d vídeos).\n", snapshot.ID, snapshot.Playlist.Title, len(snapshot.Playlist.Videos))
+	return nil
+}
+
+func (c *CLI) runSnapshots(ctx context.Context, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("uso: snapshots <playlist ID>")
+	}
+
+	snapshots, err := c.playlistUseCase.ListSnapshots(args[0])
+	if err != nil {
+		return err
+	}
+
+	if len(snapshots) == 0 {
+		fmt.Fprintln(c.out, "Nenhum snapshot encontrado.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(c.out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tDATA\tVÍDEOS\tTÍTULO")
+	for _, snapshot := range snapshots {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\n", snapshot.ID, snapshot.TakenAt.Local().Format(time.DateTime), len(snapshot.Playlist.Videos), snapshot.Playlist.Title)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,33 @@
+package cli
+
+import (
+	"TUI_playlist_reorder/infrastructure/config"
+	"flag"
+	"fmt"
+)
+
+func (c *CLI) runInit(args []string) error {
+	fs := flag.NewFlagSet("init", flag.ContinueOnError)
+	fs.SetOutput(c.out)
+	force := fs.Bool("force", false, "sobrescreve um arquivo existente")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if fs.NArg() > 1 {
+		return fmt.Errorf("uso: init [--force] [arquivo]")
+	}
+
+	// sem argumento, grava no arquivo que a própria aplicação procuraria
+	path := c.config.Path
+	if fs.NArg() == 1 {
+		path = fs.Arg(0)
+	}
+
+	if err := config.WriteDefault(path, *force); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(c.out, "Arquivo de configuração criado em %s\n", path)
+	return nil
+}
@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"TUI_playlist_reorder/internal/core/domain"
+	"context"
+	"flag"
+	"fmt"
+	"text/tabwriter"
+	"time"
+)
+
+// remoteCommands falam com a API do YouTube: rodam com o prazo de job_timeout_minutes e
+// ficam registrados no diário de operações.
+var remoteCommands = map[string]bool{
+	"diff":     true,
+	"snapshot": true,
+	"smart":    true,
+	"profiles": true,
+	"copy":     true,
+	"logout":   true,
+}
+
+var jobStatusLabels = map[domain.JobStatus]string{
+	domain.JobStarted:   "em andamento/interrompida",
+	domain.JobSucceeded: "concluída",
+	domain.JobFailed:    "erro",
+	domain.JobCancelled: "cancelada",
+	domain.JobTimedOut:  "prazo esgotado",
+}
+
+// runJob roda o comando com o prazo configurado, registrando início e fim no diário. O
+// contexto recebido já é cancelado por Ctrl+C (ver main).
+func (c *CLI) runJob(ctx context.Context, run func(ctx context.Context) error) error {
+	var cancel context.CancelFunc
+	if timeout := c.config.JobTimeout(); timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	op, _ := domain.OperationFrom(ctx)
+	started := time.Now()
+	c.recordJob(domain.JobEvent{OperationID: op.ID, Name: op.Name, Status: domain.JobStarted, At: started})
+
+	err := run(ctx)
+
+	status := domain.JobStatusFor(err)
+	if err != nil && ctx.Err() != nil {
+		status = domain.JobStatusFor(ctx.Err())
+	}
+	event := domain.JobEvent{OperationID: op.ID, Name: op.Name, Status: status, At: time.Now(), Duration: time.Since(started)}
+	if err != nil {
+		event.Err = err.Error()
+	}
+	c.recordJob(event)
+
+	switch status {
+	case domain.JobCancelled:
+		return domain.TagError(ctx, fmt.Errorf("comando cancelado: %w", err))
+	case domain.JobTimedOut:
+		return domain.TagError(ctx, fmt.Errorf("prazo de %d min esgotado: %w", c.config.JobTimeoutMinutes, err))
+	}
+	return err
+}
+
+func (c *CLI) recordJob(event domain.JobEvent) {
+	if c.journal == nil {
+		return
+	}
+	if err := c.journal.Record(event); err != nil {
+		c.logger.Warning("Falha ao gravar no diário de operações", "op_id", event.OperationID, "err", err)
+	}
+}
+
+// runJobs lista as operações remotas do diário, da TUI e da CLI, com o estado final de cada uma.
+func (c *CLI) runJobs(args []string) error {
+	fs := flag.NewFlagSet("jobs", flag.ContinueOnError)
+	fs.SetOutput(c.out)
+	last := fs.Int("last", 20, "quantidade de operações listadas (0 = todas)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("uso: jobs [--last N]")
+	}
+
+	events, err := c.journal.Events()
+	if err != nil {
+		return err
+	}
+	jobs := domain.SummarizeJobs(events)
+	if *last > 0 && len(jobs) > *last {
+		jobs = jobs[len(jobs)-*last:]
+	}
+
+	if len(jobs) == 0 {
+		fmt.Fprintf(c.out, "Nenhuma operação registrada em %s.\n", c.config.JobJournalFile)
+		return nil
+	}
+
+	w := tabwriter.NewWriter(c.out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tOPERAÇÃO\tINÍCIO\tDURAÇÃO\tSTATUS\tERRO")
+	for _, job := range jobs {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", job.OperationID, job.Name, job.StartedAt.Local().Format(time.DateTime),
+			job.Duration.Round(time.Millisecond), jobStatusLabels[job.Status], job.Err)
+	}
+	w.Flush()
+
+	fmt.Fprintln(c.out, "\nUse logs ID para ver todas as linhas de uma operação.")
+	return nil
+}
@@ -0,0 +1,25 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+)
+
+// runLogout encerra a sessão do perfil ativo (--profile): revoga o token no Google e apaga
+// o arquivo local, como o "sign out" da tela de conta.
+func (c *CLI) runLogout(ctx context.Context) error {
+	profile := c.playlistUseCase.ActiveProfile()
+
+	authService, err := c.newAuthService(profile)
+	if err != nil {
+		return err
+	}
+
+	if err := authService.SignOut(ctx); err != nil {
+		return fmt.Errorf("perfil '%s': %w", profile, err)
+	}
+
+	c.logger.Info("CLI: sessão encerrada", "profile", profile)
+	fmt.Fprintf(c.out, "Sessão do perfil '%s' encerrada: token revogado e removido.\n", profile)
+	return nil
+}
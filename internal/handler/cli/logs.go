@@ -0,0 +1,98 @@
+package cli
+
+import (
+	"TUI_playlist_reorder/infrastructure/logger"
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+	"time"
+)
+
+// runLogs lista as operações registradas nos logs ou, com um ID, mostra todas as linhas da
+// operação de ponta a ponta (TUI/CLI, use case e provider).
+func (c *CLI) runLogs(args []string) error {
+	fs := flag.NewFlagSet("logs", flag.ContinueOnError)
+	fs.SetOutput(c.out)
+	onlyErrors := fs.Bool("errors", false, "lista só as operações com erro")
+	last := fs.Int("last", 20, "quantidade de operações listadas (0 = todas)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 1 {
+		return fmt.Errorf("uso: logs [--errors] [--last N] [ID]")
+	}
+
+	lines, err := logger.ReadLogDir(c.config.LogDir)
+	if err != nil {
+		return err
+	}
+	operations := logger.GroupByOperation(lines)
+
+	if fs.NArg() == 1 {
+		id := fs.Arg(0)
+		for _, op := range operations {
+			if op.ID == id {
+				printOperation(c.out, op)
+				return nil
+			}
+		}
+		return fmt.Errorf("operação %s não encontrada nos logs de %s", id, c.config.LogDir)
+	}
+
+	if *onlyErrors {
+		var failed []logger.OperationLog
+		for _, op := range operations {
+			if op.Errors() > 0 {
+				failed = append(failed, op)
+			}
+		}
+		operations = failed
+	}
+	if *last > 0 && len(operations) > *last {
+		operations = operations[len(operations)-*last:]
+	}
+
+	if len(operations) == 0 {
+		fmt.Fprintln(c.out, "Nenhuma operação encontrada (o comando lê só logs no formato json).")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(c.out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tOPERAÇÃO\tINÍCIO\tDURAÇÃO\tLINHAS\tERROS")
+	for _, op := range operations {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%d\n", op.ID, op.Name, op.Start().Local().Format(time.DateTime),
+			op.Duration().Round(time.Millisecond), len(op.Entries), op.Errors())
+	}
+	w.Flush()
+
+	fmt.Fprintln(c.out, "\nUse logs ID para ver todas as linhas de uma operação.")
+	return nil
+}
+
+func printOperation(out io.Writer, op logger.OperationLog) {
+	fmt.Fprintf(out, "Operação %s (%s) — %s, %s, %d linhas, %d erros\n\n", op.ID, op.Name,
+		op.Start().Local().Format(time.DateTime), op.Duration().Round(time.Millisecond), len(op.Entries), op.Errors())
+
+	for _, entry := range op.Entries {
+		fmt.Fprintf(out, "%s %-7s [%s] %s", entry.Time.Local().Format("15:04:05.000"), entry.Level, entry.Component, entry.Message)
+		if entry.Err != "" {
+			fmt.Fprintf(out, ": %s", entry.Err)
+		}
+
+		keys := make([]string, 0, len(entry.Attrs))
+		for key := range entry.Attrs {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			fmt.Fprintf(out, " %s=%v", key, entry.Attrs[key])
+		}
+
+		if entry.Source != nil {
+			fmt.Fprintf(out, " (%s:%d)", entry.Source.File, entry.Source.Line)
+		}
+		fmt.Fprintln(out)
+	}
+}
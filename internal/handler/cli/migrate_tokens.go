@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"TUI_playlist_reorder/infrastructure/token_manager"
+	"fmt"
+	"os"
+)
+
+// runMigrateTokens cifra os tokens em texto puro de todos os perfis. Depois basta
+// configurar token_backend = "encrypted".
+func (c *CLI) runMigrateTokens() error {
+	store := token_manager.NewProfileStore(c.config.TokenFile, nil)
+
+	profiles, err := store.List()
+	if err != nil {
+		return err
+	}
+
+	passphrase, ok := os.LookupEnv(token_manager.PassphraseEnv)
+	if !ok || passphrase == "" {
+		passphrase, err = token_manager.ReadPassphrase(c.out, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	migrated := 0
+	for _, profile := range profiles {
+		path, err := store.TokenFile(profile)
+		if err != nil {
+			return err
+		}
+
+		converted, err := token_manager.EncryptTokenFile(path, passphrase)
+		if err != nil {
+			return fmt.Errorf("perfil '%s': %w", profile, err)
+		}
+
+		if converted {
+			migrated++
+			c.logger.Info("CLI: token cifrado", "profile", profile)
+			fmt.Fprintf(c.out, "Perfil '%s': token cifrado (%s)\n", profile, path)
+		} else {
+			fmt.Fprintf(c.out, "Perfil '%s': nada a fazer\n", profile)
+		}
+	}
+
+	fmt.Fprintf(c.out, "\n%d token(s) cifrado(s).", migrated)
+	if c.config.TokenBackend != "encrypted" {
+		fmt.Fprint(c.out, " Configure token_backend = \"encrypted\" para usá-los.")
+	}
+	fmt.Fprintln(c.out)
+	return nil
+}
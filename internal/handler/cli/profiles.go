@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"text/tabwriter"
+)
+
+func (c *CLI) runProfiles(ctx context.Context) error {
+	profiles, err := c.playlistUseCase.ListProfiles(ctx)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(c.out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "\tPERFIL\tCANAL\tID DO CANAL")
+	for _, profile := range profiles {
+		marker := ""
+		if profile.Active {
+			marker = "*"
+		}
+
+		channel, channelID := "(sem login)", ""
+		if profile.Channel != nil {
+			channel, channelID = profile.Channel.Title, profile.Channel.ID
+		} else if profile.LoggedIn {
+			channel = "(canal indisponível)"
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", marker, profile.Name, channel, channelID)
+	}
+	return w.Flush()
+}
+
+func (c *CLI) runCopy(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("copy", flag.ContinueOnError)
+	fs.SetOutput(c.out)
+	target := fs.String("to", "", "perfil de destino")
+	title := fs.String("title", "", "título da cópia (padrão: o da playlist original)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if fs.NArg() != 1 || *target == "" {
+		return fmt.Errorf("uso: copy --to PERFIL [--title T] <playlist>")
+	}
+
+	copied, err := c.playlistUseCase.CopyPlaylistToProfile(ctx, fs.Arg(0), *target, *title)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(c.out, "Playlist \"%s\" copiada de '%s' para '%s' (ID: %s, %d vídeos)\n",
+		copied.Title, c.playlistUseCase.ActiveProfile(), *target, copied.ID, len(copied.Videos))
+	return nil
+}
@@ -0,0 +1,32 @@
+package cli
+
+import (
+	"fmt"
+	"text/tabwriter"
+)
+
+func (c *CLI) runScripts() error {
+	scripts, err := c.playlistUseCase.ListScripts()
+	if err != nil {
+		return err
+	}
+
+	if len(scripts) == 0 {
+		fmt.Fprintln(c.out, "Nenhum script encontrado na biblioteca.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(c.out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "NOME\tORDENA\tFILTRA\tDESCRIÇÃO")
+	for _, script := range scripts {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", script.Name, yesNo(script.CanSort), yesNo(script.CanFilter), script.Description)
+	}
+	return w.Flush()
+}
+
+func yesNo(value bool) string {
+	if value {
+		return "sim"
+	}
+	return "não"
+}
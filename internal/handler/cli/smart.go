@@ -0,0 +1,150 @@
+package cli
+
+import (
+	"TUI_playlist_reorder/internal/core/domain"
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// stringList permite repetir uma flag, ex: --source A --source B
+type stringList []string
+
+func (s *stringList) String() string {
+	return strings.Join(*s, ",")
+}
+
+func (s *stringList) Set(value string) error {
+	*s = append(*s, value)
+	return nil
+}
+
+func (c *CLI) runSmart(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("uso: smart <add|list|preview|build|rm> [argumentos]")
+	}
+
+	switch args[0] {
+	case "add":
+		return c.runSmartAdd(args[1:])
+	case "list":
+		return c.runSmartList()
+	case "preview":
+		return c.runSmartPreview(ctx, args[1:])
+	case "build":
+		return c.runSmartBuild(ctx, args[1:])
+	case "rm":
+		if len(args) != 2 {
+			return fmt.Errorf("uso: smart rm <nome>")
+		}
+		if err := c.playlistUseCase.DeleteSmartPlaylist(args[1]); err != nil {
+			return err
+		}
+		fmt.Fprintf(c.out, "Smart playlist '%s' removida.\n", args[1])
+		return nil
+	default:
+		return fmt.Errorf("subcomando desconhecido: smart %s", args[0])
+	}
+}
+
+func (c *CLI) runSmartAdd(args []string) error {
+	fs := flag.NewFlagSet("smart add", flag.ContinueOnError)
+	fs.SetOutput(c.out)
+	var sources stringList
+	name := fs.String("name", "", "nome da definição")
+	title := fs.String("title", "", "título da playlist gerada (padrão: o nome)")
+	filter := fs.String("filter", "", "expressão de filtro, ex: 'duration < 10m and language in (pt, en)'")
+	filterScript := fs.String("filter-script", "", "script da biblioteca local usado como filtro adicional")
+	sortSpec := fs.String("sort", "", "ordenação, ex: 'published desc, title'")
+	fs.Var(&sources, "source", "playlist de origem (ID ou URL); pode ser repetida")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	definition := domain.SmartPlaylist{
+		Name:         *name,
+		Title:        *title,
+		Sources:      sources,
+		Filter:       *filter,
+		FilterScript: *filterScript,
+		Sort:         *sortSpec,
+	}
+
+	if err := c.playlistUseCase.SaveSmartPlaylist(definition); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(c.out, "Smart playlist '%s' salva.\n", definition.Name)
+	return nil
+}
+
+func (c *CLI) runSmartList() error {
+	definitions, err := c.playlistUseCase.ListSmartPlaylists()
+	if err != nil {
+		return err
+	}
+
+	if len(definitions) == 0 {
+		fmt.Fprintln(c.out, "Nenhuma smart playlist definida.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(c.out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "NOME\tORIGENS\tFILTRO\tORDENAÇÃO\tÚLTIMA GERAÇÃO")
+	for _, definition := range definitions {
+		lastBuilt := "nunca"
+		if !definition.LastBuiltAt.IsZero() {
+			lastBuilt = definition.LastBuiltAt.Local().Format(time.DateTime)
+		}
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\n", definition.Name, len(definition.Sources), definition.Filter, definition.Sort, lastBuilt)
+	}
+	return w.Flush()
+}
+
+func (c *CLI) runSmartPreview(ctx context.Context, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("uso: smart preview <nome>")
+	}
+
+	definitions, err := c.playlistUseCase.ListSmartPlaylists()
+	if err != nil {
+		return err
+	}
+
+	for _, definition := range definitions {
+		if definition.Name != args[0] {
+			continue
+		}
+
+		playlist, err := c.playlistUseCase.PreviewSmartPlaylist(ctx, definition)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(c.out, "%s (%d vídeos):\n", playlist.Title, len(playlist.Videos))
+		w := tabwriter.NewWriter(c.out, 0, 4, 2, ' ', 0)
+		for i, video := range playlist.Videos {
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", i+1, video.Title, video.Artist, video.Duration)
+		}
+		return w.Flush()
+	}
+
+	return fmt.Errorf("smart playlist '%s' não encontrada", args[0])
+}
+
+func (c *CLI) runSmartBuild(ctx context.Context, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("uso: smart build <nome>")
+	}
+
+	playlist, err := c.playlistUseCase.BuildSmartPlaylist(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(c.out, "Playlist \"%s\" gerada com %d vídeos (ID: %s).\n", playlist.Title, len(playlist.Videos), playlist.ID)
+	return nil
+}
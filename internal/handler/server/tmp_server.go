@@ -3,8 +3,10 @@ package server
 import (
 	"TUI_playlist_reorder/infrastructure/logger"
 	"context"
+	"crypto/subtle"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"time"
 )
@@ -15,15 +17,33 @@ type OAuthCallbackResult struct {
 }
 
 type CallbackHandler interface {
-	ListenAndServe(
+	// Listen reserva o endereço do callback antes de a URL de autenticação ser gerada,
+	// para que falhas de bind sejam reportadas na hora e a porta real entre no redirect URI.
+	Listen(addr string) (net.Listener, error)
+	Serve(
 		ctx context.Context,
+		listener net.Listener,
 		expectedState,
-		addr,
 		callbackPath string,
 		resultChan chan<- OAuthCallbackResult,
 	) *http.Server
 }
 
+// RedirectURL monta o redirect URI a partir do host configurado e da porta efetivamente obtida.
+func RedirectURL(addr string, listener net.Listener, callbackPath string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil || host == "" {
+		host = "127.0.0.1"
+	}
+
+	_, port, err := net.SplitHostPort(listener.Addr().String())
+	if err != nil {
+		return "http://" + listener.Addr().String() + callbackPath
+	}
+
+	return "http://" + net.JoinHostPort(host, port) + callbackPath
+}
+
 type callbackHandlerImpl struct {
 	logger logger.Logger
 }
@@ -35,17 +55,37 @@ func NewCallbackHandler(logger logger.Logger) CallbackHandler {
 	}
 }
 
-func (h *callbackHandlerImpl) ListenAndServe(
+// Listen abre o listener TCP; sem host, usa apenas a interface de loopback para não expor
+// o callback na rede local. Porta 0 pede ao sistema uma porta livre.
+func (h *callbackHandlerImpl) Listen(addr string) (net.Listener, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, fmt.Errorf("endereço de callback inválido '%s': %w", addr, err)
+	}
+	if host == "" {
+		host = "127.0.0.1"
+	}
+
+	listener, err := net.Listen("tcp", net.JoinHostPort(host, port))
+	if err != nil {
+		return nil, fmt.Errorf("não foi possível abrir o servidor de callback em %s: %w", net.JoinHostPort(host, port), err)
+	}
+
+	h.logger.Info("Servidor de callback escutando", "addr", listener.Addr().String())
+
+	return listener, nil
+}
+
+func (h *callbackHandlerImpl) Serve(
 	ctx context.Context,
+	listener net.Listener,
 	expectedState string,
-	addr string,
 	callbackPath string,
 	resultChan chan<- OAuthCallbackResult,
 ) *http.Server {
 	mux := http.NewServeMux()
 
 	httpServer := &http.Server{
-		Addr:    ":8080",
 		Handler: mux,
 	}
 
@@ -54,10 +94,11 @@ func (h *callbackHandlerImpl) ListenAndServe(
 	mux.HandleFunc(callbackPath, func(w http.ResponseWriter, r *http.Request) {
 		defer close(handlerDone)
 
+		// comparação em tempo constante para não vazar o state esperado
 		state := r.URL.Query().Get("state")
-		if state != expectedState {
-			err := fmt.Errorf("state CSRF inválido. Recebido: '%s', Esperado: '%s'", state, expectedState)
-			h.logger.Error("Erro de state CSRF: %v", err) // Exemplo de como seria com logger
+		if subtle.ConstantTimeCompare([]byte(state), []byte(expectedState)) != 1 {
+			err := fmt.Errorf("state CSRF inválido")
+			h.logger.Error("Erro de state CSRF", err)
 
 			http.Error(w, "Invalid state. Please try the authentication process again.", http.StatusBadRequest)
 			resultChan <- OAuthCallbackResult{Error: err}
@@ -74,7 +115,7 @@ func (h *callbackHandlerImpl) ListenAndServe(
 				errMsg = fmt.Errorf("erro de autorização do provedor OAuth: %s", authErrParam)
 			}
 
-			h.logger.Error("Erro do provedor OAuth: %v", errMsg)
+			h.logger.Error("Erro do provedor OAuth", errMsg, "oauth_error", authErrParam)
 
 			http.Error(w, "An error occurred during authorization with the provider. You can close this tab.", http.StatusUnauthorized) // Resposta HTTP adicionada
 			resultChan <- OAuthCallbackResult{Error: errMsg}
@@ -99,11 +140,11 @@ func (h *callbackHandlerImpl) ListenAndServe(
 	})
 
 	go func() {
-		h.logger.Info("Iniciando servidor de callback em " + addr + " - " + callbackPath)
+		h.logger.Info("Iniciando servidor de callback", "addr", listener.Addr().String(), "callback_path", callbackPath)
 
-		if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		if err := httpServer.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
 			wrappedErr := fmt.Errorf("falha crítica ao iniciar servidor de callback HTTP: %w", err)
-			h.logger.Error("%v", wrappedErr)
+			h.logger.Error("Falha ao iniciar servidor de callback HTTP", err, "addr", listener.Addr().String())
 
 			select {
 			case resultChan <- OAuthCallbackResult{Error: wrappedErr}:
@@ -111,7 +152,7 @@ func (h *callbackHandlerImpl) ListenAndServe(
 			}
 		}
 
-		h.logger.Info("Servidor de callback HTTP: ListenAndServe retornou.")
+		h.logger.Info("Servidor de callback HTTP: Serve retornou.")
 	}()
 
 	go func() {
@@ -119,7 +160,7 @@ func (h *callbackHandlerImpl) ListenAndServe(
 		case <-handlerDone:
 			h.logger.Info("Servidor de callback OAuth: Handler concluiu, iniciando shutdown.")
 		case <-ctx.Done():
-			h.logger.Info("Servidor de callback OAuth: Contexto cancelado/timeout " + ctx.Err().Error() + ", iniciando shutdown.")
+			h.logger.Info("Contexto do callback encerrado, iniciando shutdown", "reason", ctx.Err())
 		}
 
 		h.logger.Info("Iniciando shutdown do servidor de callback...")
@@ -127,7 +168,7 @@ func (h *callbackHandlerImpl) ListenAndServe(
 		defer cancelShutdown()
 
 		if err := httpServer.Shutdown(shutdownCtx); err != nil {
-			h.logger.Error("Erro ao desligar servidor de callback HTTP: ", err)
+			h.logger.Error("Erro ao desligar servidor de callback HTTP", err)
 		} else {
 			h.logger.Info("Servidor de callback HTTP desligado com sucesso.")
 		}
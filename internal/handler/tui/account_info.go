@@ -0,0 +1,176 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"TUI_playlist_reorder/infrastructure/auth"
+	"TUI_playlist_reorder/internal/core/domain"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type accountInfoLoadedMsg struct {
+	channel    *domain.Channel
+	channelErr error
+	details    auth.TokenDetails
+	detailsErr error
+}
+type signedOutMsg struct{ err error }
+
+// AccountInfoModel mostra a conta do perfil ativo (canal, validade do token e escopos
+// concedidos) e permite encerrar a sessão revogando o token.
+type AccountInfoModel struct {
+	parent *AppModel
+
+	info       accountInfoLoadedMsg
+	loading    bool
+	confirming bool
+	signingOut bool
+}
+
+func NewAccountInfoModel(parent *AppModel) *AccountInfoModel {
+	return &AccountInfoModel{parent: parent, loading: true}
+}
+
+func (m *AccountInfoModel) Init() tea.Cmd {
+	m.loading = true
+	m.confirming = false
+
+	return func() tea.Msg {
+		ctx := m.parent.operation("account")
+		var msg accountInfoLoadedMsg
+
+		channel, err := m.parent.playlistUseCase.GetMyChannel(ctx)
+		if err != nil {
+			msg.channelErr = err
+		} else {
+			msg.channel = &channel
+		}
+
+		msg.details, msg.detailsErr = m.parent.authService.TokenDetails(ctx)
+		return msg
+	}
+}
+
+func (m *AccountInfoModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case accountInfoLoadedMsg:
+		m.loading = false
+		m.info = msg
+		return m, nil
+
+	case signedOutMsg:
+		m.signingOut = false
+		if msg.err != nil {
+			// o token local já foi apagado; só a revogação remota falhou, e a tela inicial
+			// mostra o erro
+			m.parent.logger.Error("Falha ao revogar o token", msg.err)
+		}
+		m.parent.logger.Info("Sessão encerrada", "profile", m.parent.profile)
+		return m, m.parent.send(showWelcomeMsg{signOutErr: msg.err})
+
+	case tea.KeyMsg:
+		if m.loading || m.signingOut {
+			return m, nil
+		}
+
+		if m.confirming {
+			if msg.Type == tea.KeyRunes && string(msg.Runes) == "y" {
+				m.confirming = false
+				m.signingOut = true
+				return m, func() tea.Msg {
+					return signedOutMsg{err: m.parent.authService.SignOut(m.parent.operation("logout"))}
+				}
+			}
+			m.confirming = false
+			return m, nil
+		}
+
+		switch msg.Type {
+		case tea.KeyBackspace:
+			return m, m.parent.send(showPlaylistsMsg{})
+		case tea.KeyRunes:
+			if string(msg.Runes) == "x" {
+				m.confirming = true
+			}
+		}
+	}
+
+	return m, nil
+}
+
+func (m *AccountInfoModel) View() string {
+	var b strings.Builder
+
+	b.WriteString(listHeaderStyle.Render("Conta"))
+	b.WriteString("\n")
+	b.WriteString(welcomePromptStyle.Render(fmt.Sprintf("Perfil: %s", m.parent.profile)))
+	b.WriteString("\n\n")
+
+	if m.loading {
+		b.WriteString("⏳ Carregando dados da conta…\n")
+		return docStyle.Render(b.String())
+	}
+	if m.signingOut {
+		b.WriteString("⏳ ")
+		b.WriteString(statusMessageStyle.Render("Revogando o token e encerrando a sessão…"))
+		b.WriteString("\n")
+		return docStyle.Render(b.String())
+	}
+
+	b.WriteString(listItemStyle.Render("Canal: "))
+	if m.info.channel != nil {
+		channel := m.info.channel.Title
+		if m.info.channel.CustomURL != "" {
+			channel += " (" + m.info.channel.CustomURL + ")"
+		}
+		b.WriteString(channel + " — " + m.info.channel.ID)
+	} else {
+		b.WriteString(errorMessageStyle.Render(fmt.Sprintf("indisponível: %v", m.info.channelErr)))
+	}
+	b.WriteString("\n")
+
+	if m.info.detailsErr != nil && m.info.details.Expiry.IsZero() {
+		b.WriteString(errorMessageStyle.Render(fmt.Sprintf("Token: %v", m.info.detailsErr)))
+		b.WriteString("\n")
+	} else {
+		expiry := "sem validade informada"
+		if !m.info.details.Expiry.IsZero() {
+			expiry = fmt.Sprintf("%s (em %s)", m.info.details.Expiry.Local().Format("02/01/2006 15:04"),
+				time.Until(m.info.details.Expiry).Round(time.Minute))
+		}
+		b.WriteString(listItemStyle.Render("Access token expira: "))
+		b.WriteString(expiry)
+		b.WriteString("\n")
+
+		refresh := "não (será preciso logar de novo quando expirar)"
+		if m.info.details.HasRefreshToken {
+			refresh = "sim (renovado automaticamente)"
+		}
+		b.WriteString(listItemStyle.Render("Refresh token: "))
+		b.WriteString(refresh)
+		b.WriteString("\n")
+
+		b.WriteString(listItemStyle.Render("Escopos concedidos:"))
+		b.WriteString("\n")
+		if m.info.detailsErr != nil {
+			b.WriteString(errorMessageStyle.Render(fmt.Sprintf("  indisponíveis: %v", m.info.detailsErr)))
+			b.WriteString("\n")
+		}
+		for _, scope := range m.info.details.Scopes {
+			b.WriteString("  • " + scope + "\n")
+		}
+	}
+	b.WriteString("\n")
+
+	if m.confirming {
+		b.WriteString(errorMessageStyle.Render("Encerrar a sessão? O token será revogado no Google e apagado deste computador. (y para confirmar)"))
+		b.WriteString("\n")
+		return docStyle.Render(b.String())
+	}
+
+	b.WriteString(welcomePromptStyle.Render("x encerra a sessão (sign out), Backspace volta."))
+	return docStyle.Render(b.String())
+}
@@ -0,0 +1,274 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"TUI_playlist_reorder/infrastructure/token_manager"
+	"TUI_playlist_reorder/internal/core/domain"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type profilesLoadedMsg struct{ profiles []domain.Profile }
+type profileCopiedMsg struct {
+	playlist domain.Playlist
+	profile  string
+}
+type accountsErrorMsg struct{ err error }
+
+// AccountsModel lista os perfis (contas Google e canais de marca) e permite trocar o ativo.
+// Com copySource definido, a tela serve para escolher o perfil de destino de uma cópia.
+type AccountsModel struct {
+	parent     *AppModel
+	profiles   []domain.Profile
+	cursor     int
+	copySource *domain.Playlist
+
+	creating bool
+	input    textinput.Model
+
+	loading       bool
+	statusMessage string
+	err           error
+}
+
+func NewAccountsModel(parent *AppModel, copySource *domain.Playlist) *AccountsModel {
+	return &AccountsModel{
+		parent:     parent,
+		copySource: copySource,
+		loading:    true,
+	}
+}
+
+func (m *AccountsModel) Init() tea.Cmd {
+	m.loading = true
+	m.err = nil
+	m.creating = false
+
+	return func() tea.Msg {
+		profiles, err := m.parent.playlistUseCase.ListProfiles(m.parent.operation("profiles"))
+		if err != nil {
+			return accountsErrorMsg{err: err}
+		}
+		return profilesLoadedMsg{profiles: profiles}
+	}
+}
+
+// activate troca para o perfil e vai para as playlists, ou para o login se ainda não houver token.
+func (m *AccountsModel) activate(profile string) tea.Cmd {
+	if err := m.parent.switchProfile(profile); err != nil {
+		m.err = err
+		return nil
+	}
+
+	if _, err := m.parent.tokenService.LoadToken(); err != nil {
+		return m.parent.send(showWelcomeMsg{})
+	}
+	return m.parent.send(showPlaylistsMsg{})
+}
+
+func (m *AccountsModel) back() tea.Cmd {
+	if m.copySource != nil {
+		return m.parent.send(showReorderMsg{playlist: *m.copySource})
+	}
+	return m.parent.send(showPlaylistsMsg{})
+}
+
+// typing indica se a caixa do nome do novo perfil está aberta; Esc a fecha.
+func (m *AccountsModel) typing() bool {
+	return m.creating
+}
+
+func (m *AccountsModel) updateCreating(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEnter:
+		name := strings.TrimSpace(m.input.Value())
+		if err := token_manager.ValidateProfileName(name); err != nil {
+			m.err = err
+			return m, nil
+		}
+		m.creating = false
+		return m, m.activate(name)
+
+	case tea.KeyCtrlX, tea.KeyEsc:
+		m.creating = false
+		m.err = nil
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	return m, cmd
+}
+
+func (m *AccountsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case profilesLoadedMsg:
+		m.loading = false
+		m.profiles = msg.profiles
+		for i, profile := range m.profiles {
+			if profile.Active {
+				m.cursor = i
+			}
+		}
+		return m, nil
+
+	case profileCopiedMsg:
+		m.loading = false
+		m.statusMessage = fmt.Sprintf("Playlist \"%s\" copiada para o perfil '%s' (%d vídeos).", msg.playlist.Title, msg.profile, len(msg.playlist.Videos))
+		return m, nil
+
+	case accountsErrorMsg:
+		m.loading = false
+		m.err = msg.err
+		m.parent.logger.Error("AccountsModel: erro", msg.err)
+		return m, nil
+
+	case tea.KeyMsg:
+		if m.loading {
+			return m, nil
+		}
+
+		if m.creating {
+			return m.updateCreating(msg)
+		}
+
+		switch msg.Type {
+		case tea.KeyUp:
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case tea.KeyDown:
+			if m.cursor < len(m.profiles)-1 {
+				m.cursor++
+			}
+		case tea.KeyBackspace:
+			return m, m.back()
+		case tea.KeyEnter:
+			if len(m.profiles) == 0 {
+				return m, nil
+			}
+			selected := m.profiles[m.cursor]
+
+			if m.copySource == nil {
+				return m, m.activate(selected.Name)
+			}
+
+			if selected.Active {
+				m.err = fmt.Errorf("escolha um perfil diferente do ativo")
+				return m, nil
+			}
+			if !selected.LoggedIn {
+				m.err = fmt.Errorf("o perfil '%s' ainda não fez login", selected.Name)
+				return m, nil
+			}
+
+			source := *m.copySource
+			m.loading = true
+			m.err = nil
+			m.statusMessage = fmt.Sprintf("Copiando \"%s\" para o perfil '%s'. Aguarde... (Esc cancela)", source.Title, selected.Name)
+			return m, func() tea.Msg {
+				ctx := m.parent.startJob("copy")
+				copied, err := m.parent.playlistUseCase.CopyPlaylistToProfile(ctx, source.ID, selected.Name, "")
+				err = m.parent.finishJob(ctx, err)
+				if err != nil {
+					return accountsErrorMsg{err: err}
+				}
+				return profileCopiedMsg{playlist: copied, profile: selected.Name}
+			}
+		case tea.KeyRunes:
+			if string(msg.Runes) == "n" && m.copySource == nil {
+				m.creating = true
+				m.err = nil
+				m.input = textinput.New()
+				m.input.Prompt = "> "
+				m.input.Placeholder = "marca-podcast"
+				m.input.CharLimit = 64
+				return m, m.input.Focus()
+			}
+		}
+	}
+
+	return m, nil
+}
+
+func (m *AccountsModel) View() string {
+	var b strings.Builder
+
+	if m.copySource != nil {
+		b.WriteString(listHeaderStyle.Render(fmt.Sprintf("Copiar \"%s\" para outro perfil", m.copySource.Title)))
+	} else {
+		b.WriteString(listHeaderStyle.Render("Contas"))
+	}
+	b.WriteString("\n\n")
+
+	if m.loading {
+		b.WriteString("⏳ ")
+		if m.statusMessage != "" {
+			b.WriteString(statusMessageStyle.Render(m.statusMessage))
+		} else {
+			b.WriteString("Carregando perfis…")
+		}
+		b.WriteString("\n")
+		return docStyle.Render(b.String())
+	}
+
+	if m.creating {
+		b.WriteString(welcomePromptStyle.Render("Nome do novo perfil"))
+		b.WriteString("\n")
+		b.WriteString(m.input.View())
+		b.WriteString("\n\n")
+		if m.err != nil {
+			b.WriteString(errorMessageStyle.Render(fmt.Sprintf("Erro: %v", m.err)))
+			b.WriteString("\n\n")
+		}
+		b.WriteString(welcomePromptStyle.Render("Enter cria o perfil e abre o login, Esc ou Ctrl+X cancela."))
+		return docStyle.Render(b.String())
+	}
+
+	for i, profile := range m.profiles {
+		marker := "  "
+		if profile.Active {
+			marker = "● "
+		}
+
+		channel := "sem login"
+		if profile.Channel != nil {
+			channel = profile.Channel.Title
+			if profile.Channel.CustomURL != "" {
+				channel += " (" + profile.Channel.CustomURL + ")"
+			}
+		} else if profile.LoggedIn {
+			channel = "canal indisponível"
+		}
+
+		line := fmt.Sprintf("%s%s — %s", marker, profile.Name, channel)
+		if i == m.cursor {
+			b.WriteString(selectedListItemStyle.Render(line))
+		} else {
+			b.WriteString(listItemStyle.Render(line))
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+
+	if m.statusMessage != "" {
+		b.WriteString(statusMessageStyle.Render(m.statusMessage))
+		b.WriteString("\n")
+	}
+	if m.err != nil {
+		b.WriteString(errorMessageStyle.Render(fmt.Sprintf("Erro: %v", m.err)))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	if m.copySource != nil {
+		b.WriteString(welcomePromptStyle.Render("Enter copia para o perfil selecionado, Backspace volta."))
+	} else {
+		b.WriteString(welcomePromptStyle.Render("Enter ativa o perfil, n cria um novo, Backspace volta."))
+	}
+
+	return docStyle.Render(b.String())
+}
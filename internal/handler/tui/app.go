@@ -7,9 +7,11 @@ import (
 	"TUI_playlist_reorder/infrastructure/logger"
 	"TUI_playlist_reorder/infrastructure/token_manager"
 	"TUI_playlist_reorder/internal/core/domain"
+	"TUI_playlist_reorder/internal/core/ports"
 	"TUI_playlist_reorder/internal/core/usecases"
 	"TUI_playlist_reorder/internal/handler/server"
 	"context"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 )
@@ -22,8 +24,34 @@ const (
 	viewPlaylists
 	viewReorder
 	viewURL
+	viewDedupe
+	viewCrossDuplicates
+	viewDiff
+	viewSmartPlaylists
+	viewAccounts
+	viewAccountInfo
 )
 
+// Settings são as preferências vindas da configuração que afetam a TUI.
+type Settings struct {
+	CallbackAddress string        // endereço do servidor de callback do OAuth2
+	LoginMethod     string        // browser, manual ou device (já resolvido a partir de "auto")
+	DefaultSort     string        // critério pré-selecionado na tela de reordenação
+	TitleTemplate   string        // text/template do título sugerido ao salvar
+	JobTimeout      time.Duration // prazo de cada busca ou gravação; zero = sem prazo
+}
+
+// Session agrupa os serviços ligados a um perfil (conta Google); trocar de perfil troca a sessão.
+type Session struct {
+	Profile         string
+	AuthService     auth.AuthenticationService
+	TokenService    token_manager.TokenService
+	PlaylistUseCase usecases.PlaylistUseCase
+}
+
+// SessionFactory cria a sessão de um perfil, usada pela tela de contas para trocar de perfil.
+type SessionFactory func(profile string) (Session, error)
+
 type AppModel struct {
 	// Dependências injetadas
 	authService     auth.AuthenticationService
@@ -31,39 +59,61 @@ type AppModel struct {
 	playlistUseCase usecases.PlaylistUseCase
 	tokenService    token_manager.TokenService
 	logger          logger.Logger
+	journal         ports.JobJournalPort
+	settings        Settings
+
+	profile    string
+	newSession SessionFactory
 
 	welcomeModel   *WelcomeModel
 	loginModel     *LoginModel
 	playlistsModel *PlaylistsModel
 	reorderModel   *ReorderModel
 	urlModel       *URLModel
+	dedupeModel    *DedupeModel
+	crossDupModel  *CrossDuplicatesModel
+	diffModel      *DiffModel
+	smartModel     *SmartPlaylistsModel
+	accountsModel  *AccountsModel
+	accountInfo    *AccountInfoModel
+	logViewer      *LogViewerModel
 
 	currentView currentView
 	err         error
 
 	appContext context.Context
 	cancelApp  context.CancelFunc
+	jobs       jobRegistry
+
+	// aviso sobre a gravação em andamento (interrupção pedida, saída no meio), mostrado até a próxima tecla
+	notice    string
+	quitArmed bool // Ctrl+C já avisado durante uma gravação; o próximo encerra
 
 	width  int
 	height int
 }
 
 func NewAppModel(
-	authSvc auth.AuthenticationService,
+	session Session,
+	newSession SessionFactory,
 	cbHandler server.CallbackHandler,
-	playlistUC usecases.PlaylistUseCase,
-	tokenSvc token_manager.TokenService,
 	log logger.Logger,
+	journal ports.JobJournalPort,
+	settings Settings,
 ) *AppModel {
 	// Cria contexto principal que será cancelado no Quit
 	appCtx, cancel := context.WithCancel(context.Background())
 
 	m := &AppModel{
-		authService:     authSvc,
+		authService:     session.AuthService,
 		callbackHandler: cbHandler,
-		playlistUseCase: playlistUC,
-		tokenService:    tokenSvc,
+		playlistUseCase: session.PlaylistUseCase,
+		tokenService:    session.TokenService,
 		logger:          log,
+		journal:         journal,
+		settings:        settings,
+		profile:         session.Profile,
+		newSession:      newSession,
 
 		appContext: appCtx,
 		cancelApp:  cancel,
@@ -75,6 +125,7 @@ func NewAppModel(
 	m.playlistsModel = NewPlaylistsModel(m)
 	m.urlModel = NewURLModel(m)
 	m.reorderModel = NewReorderModel(m, domain.Playlist{})
+	m.logViewer = NewLogViewerModel(m)
 
 	m.currentView = viewWelcome
 	return m
@@ -96,26 +147,128 @@ func (m *AppModel) Init() tea.Cmd {
 }
 
 // Mensagens de navegação que os sub-modelos usam
-type showWelcomeMsg struct{}
+type showWelcomeMsg struct{ signOutErr error }
 type showLoginMsg struct{}
 type showPlaylistsMsg struct{}
 type showReorderMsg struct{ playlist domain.Playlist }
 type showURLMsg struct{}
+type showDedupeMsg struct{ playlist domain.Playlist }
+type showCrossDuplicatesMsg struct{}
+type showDiffMsg struct{ playlist domain.Playlist }
+type showSmartPlaylistsMsg struct{}
+type showAccountsMsg struct{ copySource *domain.Playlist }
+type showAccountInfoMsg struct{}
+
+// switchProfile troca todos os serviços da sessão pelos do perfil informado.
+func (m *AppModel) switchProfile(profile string) error {
+	session, err := m.newSession(profile)
+	if err != nil {
+		return err
+	}
+
+	m.authService = session.AuthService
+	m.tokenService = session.TokenService
+	m.playlistUseCase = session.PlaylistUseCase
+	m.profile = session.Profile
+	m.logger.Info("Perfil ativo alterado", "profile", m.profile)
+	return nil
+}
 
 func (m *AppModel) send(msg tea.Msg) tea.Cmd {
 	return func() tea.Msg { return msg }
 }
 
+// operation abre uma operação (com ID de correlação nos logs e erros) para uma ação do usuário.
+func (m *AppModel) operation(name string) context.Context {
+	return domain.StartOperation(m.appContext, name)
+}
+
+// typing indica se a tela atual está com uma caixa de texto ou pergunta aberta que trata o
+// Esc sozinha.
+func (m *AppModel) typing() bool {
+	switch m.currentView {
+	case viewLogin:
+		return m.loginModel != nil && m.loginModel.typing()
+	case viewPlaylists:
+		return m.playlistsModel != nil && m.playlistsModel.typing()
+	case viewReorder:
+		return m.reorderModel != nil && m.reorderModel.typing()
+	case viewDedupe:
+		return m.dedupeModel != nil && m.dedupeModel.typing()
+	case viewSmartPlaylists:
+		return m.smartModel != nil && m.smartModel.typing()
+	case viewAccounts:
+		return m.accountsModel != nil && m.accountsModel.typing()
+	}
+	return false
+}
+
+// quit encerra a aplicação, cancelando o que ainda estiver em andamento.
+func (m *AppModel) quit() tea.Msg {
+	m.logger.Info("Esc pressionado, encerrando app.")
+	m.cancelApp()
+	return tea.Quit()
+}
+
 func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmd tea.Cmd
 	var cmds []tea.Cmd
 
+	// O painel de logs fica por cima da tela atual: recebe as teclas enquanto aberto e as
+	// próprias mensagens de leitura; o resto continua indo para a tela atual
+	switch msg := msg.(type) {
+	case logTickMsg, logLinesMsg:
+		_, cmd = m.logViewer.Update(msg)
+		return m, cmd
+	case tea.KeyMsg:
+		if msg.Type == tea.KeyCtrlL {
+			return m, m.logViewer.Toggle()
+		}
+		if m.logViewer.open && msg.Type != tea.KeyCtrlC {
+			if msg.Type == tea.KeyEsc && !m.logViewer.searching {
+				return m, m.logViewer.Toggle()
+			}
+			_, cmd = m.logViewer.Update(msg)
+			return m, cmd
+		}
+	}
+
 	// Tratamos keys globais (Ctrl+C, Esc)
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		m.notice = ""
+		if msg.Type != tea.KeyCtrlC {
+			m.quitArmed = false
+		}
+
 		switch msg.Type {
-		case tea.KeyCtrlC, tea.KeyEsc:
-			m.logger.Info("Ctrl+C ou Esc pressionado, encerrando app.")
+		case tea.KeyEsc:
+			// com uma caixa de texto aberta (busca com /, pulo para posição), Esc só fecha a caixa
+			if m.typing() {
+				break
+			}
+			// Esc interrompe primeiro a busca ou gravação em andamento; a tela recebe o
+			// resultado cancelado como qualquer outro erro. Uma gravação que já começou a
+			// alterar o YouTube para antes da escrita seguinte
+			cancelled, writing := m.cancelJobs()
+			if writing > 0 {
+				m.notice = "Interrompendo a gravação depois do item em andamento..."
+			}
+			if cancelled > 0 {
+				return m, nil
+			}
+			// edições da sessão de reordenação não salvas pedem confirmação antes de sumir
+			if m.currentView == viewReorder && m.reorderModel != nil {
+				return m, m.reorderModel.leave(m.quit)
+			}
+			return m, m.quit
+		case tea.KeyCtrlC:
+			if m.writingJobs() && !m.quitArmed {
+				m.quitArmed = true
+				m.notice = "Gravação em andamento no YouTube: sair agora deixa a playlist pela metade. Esc interrompe a gravação; Ctrl+C de novo sai mesmo assim."
+				return m, nil
+			}
+			m.logger.Info("Ctrl+C pressionado, encerrando app.")
 			m.cancelApp()
 			return m, tea.Quit
 		}
@@ -160,6 +313,7 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case showWelcomeMsg:
 		m.currentView = viewWelcome
 		m.err = nil
+		m.welcomeModel.err = msg.signOutErr
 		cmd = m.welcomeModel.Init()
 
 	case showLoginMsg:
@@ -188,6 +342,48 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		um := NewURLModel(m)
 		m.urlModel = um
 		cmd = um.Init()
+
+	case showDedupeMsg:
+		m.currentView = viewDedupe
+		m.err = nil
+		dm := NewDedupeModel(m, msg.playlist)
+		m.dedupeModel = dm
+		cmd = dm.Init()
+
+	case showCrossDuplicatesMsg:
+		m.currentView = viewCrossDuplicates
+		m.err = nil
+		cm := NewCrossDuplicatesModel(m)
+		m.crossDupModel = cm
+		cmd = cm.Init()
+
+	case showDiffMsg:
+		m.currentView = viewDiff
+		m.err = nil
+		dm := NewDiffModel(m, msg.playlist)
+		m.diffModel = dm
+		cmd = dm.Init()
+
+	case showSmartPlaylistsMsg:
+		m.currentView = viewSmartPlaylists
+		m.err = nil
+		sm := NewSmartPlaylistsModel(m)
+		m.smartModel = sm
+		cmd = sm.Init()
+
+	case showAccountsMsg:
+		m.currentView = viewAccounts
+		m.err = nil
+		am := NewAccountsModel(m, msg.copySource)
+		m.accountsModel = am
+		cmd = am.Init()
+
+	case showAccountInfoMsg:
+		m.currentView = viewAccountInfo
+		m.err = nil
+		im := NewAccountInfoModel(m)
+		m.accountInfo = im
+		cmd = im.Init()
 	}
 
 	cmds = append(cmds, cmd)
@@ -240,6 +436,59 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			currentViewCmd = cmd
 		}
 
+	case viewDedupe:
+		if m.dedupeModel != nil {
+			updated, cmd := m.dedupeModel.Update(msg)
+			if casted, ok := updated.(*DedupeModel); ok {
+				m.dedupeModel = casted
+			}
+			currentViewCmd = cmd
+		}
+
+	case viewCrossDuplicates:
+		if m.crossDupModel != nil {
+			updated, cmd := m.crossDupModel.Update(msg)
+			if casted, ok := updated.(*CrossDuplicatesModel); ok {
+				m.crossDupModel = casted
+			}
+			currentViewCmd = cmd
+		}
+
+	case viewDiff:
+		if m.diffModel != nil {
+			updated, cmd := m.diffModel.Update(msg)
+			if casted, ok := updated.(*DiffModel); ok {
+				m.diffModel = casted
+			}
+			currentViewCmd = cmd
+		}
+
+	case viewSmartPlaylists:
+		if m.smartModel != nil {
+			updated, cmd := m.smartModel.Update(msg)
+			if casted, ok := updated.(*SmartPlaylistsModel); ok {
+				m.smartModel = casted
+			}
+			currentViewCmd = cmd
+		}
+
+	case viewAccounts:
+		if m.accountsModel != nil {
+			updated, cmd := m.accountsModel.Update(msg)
+			if casted, ok := updated.(*AccountsModel); ok {
+				m.accountsModel = casted
+			}
+			currentViewCmd = cmd
+		}
+
+	case viewAccountInfo:
+		if m.accountInfo != nil {
+			updated, cmd := m.accountInfo.Update(msg)
+			if casted, ok := updated.(*AccountInfoModel); ok {
+				m.accountInfo = casted
+			}
+			currentViewCmd = cmd
+		}
 	}
 
 	cmds = append(cmds, currentViewCmd)
@@ -247,9 +496,19 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 }
 
 func (m *AppModel) View() string {
+	if m.notice != "" {
+		return m.view() + "\n" + errorMessageStyle.Render(m.notice)
+	}
+	return m.view()
+}
+
+func (m *AppModel) view() string {
 	if m.err != nil {
 		return fmt.Sprintf("Ocorreu um erro: %v\n\n(Ctrl+C para sair)", m.err)
 	}
+	if m.logViewer.open {
+		return m.logViewer.View()
+	}
 
 	switch m.currentView {
 	case viewWelcome:
@@ -262,6 +521,18 @@ func (m *AppModel) View() string {
 		return m.reorderModel.View()
 	case viewURL:
 		return m.urlModel.View()
+	case viewDedupe:
+		return m.dedupeModel.View()
+	case viewCrossDuplicates:
+		return m.crossDupModel.View()
+	case viewDiff:
+		return m.diffModel.View()
+	case viewSmartPlaylists:
+		return m.smartModel.View()
+	case viewAccounts:
+		return m.accountsModel.View()
+	case viewAccountInfo:
+		return m.accountInfo.View()
 	default:
 		return "Visão desconhecida…"
 	}
@@ -0,0 +1,447 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"TUI_playlist_reorder/internal/core/domain"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type duplicatesLoadedMsg struct {
+	playlist domain.Playlist
+	groups   []domain.DuplicateGroup
+}
+type duplicatesErrorMsg struct{ err error }
+type duplicatesRemovedMsg struct {
+	count   int
+	inPlace bool
+	title   string
+}
+
+type dedupeRow struct {
+	kind     domain.DuplicateKind
+	header   bool
+	position int
+}
+
+type DedupeModel struct {
+	parent   *AppModel
+	playlist domain.Playlist
+
+	groups   []domain.DuplicateGroup
+	rows     []dedupeRow
+	selected map[int]bool // posições marcadas para remoção
+	cursor   int
+
+	loading           bool
+	awaitingTitle     bool
+	confirmingRemoval bool
+	newTitle          string
+	saving            bool
+
+	statusMessage string
+	err           error
+}
+
+func NewDedupeModel(parent *AppModel, playlist domain.Playlist) *DedupeModel {
+	return &DedupeModel{
+		parent:   parent,
+		playlist: playlist,
+		selected: make(map[int]bool),
+		loading:  true,
+	}
+}
+
+func (m *DedupeModel) Init() tea.Cmd {
+	m.loading = true
+	m.err = nil
+	m.statusMessage = ""
+	m.parent.logger.Info("DedupeModel: buscando duplicatas", "playlist_id", m.playlist.ID, "title", m.playlist.Title)
+
+	playlistID := m.playlist.ID
+	return func() tea.Msg {
+		ctx := m.parent.startJob("dedupe")
+		playlist, groups, err := m.parent.playlistUseCase.FindDuplicates(ctx, playlistID)
+		err = m.parent.finishJob(ctx, err)
+		if err != nil {
+			return duplicatesErrorMsg{err: err}
+		}
+		return duplicatesLoadedMsg{playlist: playlist, groups: groups}
+	}
+}
+
+func (m *DedupeModel) buildRows() {
+	m.rows = nil
+	m.selected = make(map[int]bool)
+
+	for _, group := range m.groups {
+		m.rows = append(m.rows, dedupeRow{kind: group.Kind, header: true})
+		for i, pos := range group.Positions {
+			m.rows = append(m.rows, dedupeRow{kind: group.Kind, position: pos})
+			// por padrão mantém a primeira ocorrência e marca as demais, mas só nas duplicatas
+			// exatas: quase duplicatas podem ser versões diferentes e ficam para o usuário decidir
+			if i > 0 && group.Kind == domain.DuplicateExact {
+				m.selected[pos] = true
+			}
+		}
+	}
+
+	m.cursor = 0
+	m.moveCursor(1)
+}
+
+// moveCursor move o cursor pulando as linhas de cabeçalho de grupo.
+func (m *DedupeModel) moveCursor(delta int) {
+	for next := m.cursor + delta; next >= 0 && next < len(m.rows); next += delta {
+		if !m.rows[next].header {
+			m.cursor = next
+			return
+		}
+	}
+}
+
+func (m *DedupeModel) selectedPositions() []int {
+	var positions []int
+	for pos, ok := range m.selected {
+		if ok {
+			positions = append(positions, pos)
+		}
+	}
+	return positions
+}
+
+func (m *DedupeModel) removeCmd(inPlace bool, title string) tea.Cmd {
+	positions := m.selectedPositions()
+	playlist := m.playlist
+	return func() tea.Msg {
+		ctx := m.parent.startJob("dedupe-remove")
+		err := m.parent.playlistUseCase.RemoveDuplicates(ctx, playlist, positions, inPlace, title)
+		err = m.parent.finishJob(ctx, err)
+		if err != nil {
+			return duplicatesErrorMsg{err: err}
+		}
+		return duplicatesRemovedMsg{count: len(positions), inPlace: inPlace, title: title}
+	}
+}
+
+// typing indica se a tela está com o título da cópia ou a confirmação abertos, em que Esc
+// fecha só eles.
+func (m *DedupeModel) typing() bool {
+	return m.awaitingTitle || m.confirmingRemoval
+}
+
+func (m *DedupeModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case duplicatesLoadedMsg:
+		m.loading = false
+		m.playlist = msg.playlist
+		m.groups = msg.groups
+		m.buildRows()
+		if len(m.groups) == 0 && m.statusMessage == "" {
+			m.statusMessage = "Nenhuma duplicata encontrada nesta playlist."
+		}
+		return m, nil
+
+	case duplicatesErrorMsg:
+		m.loading = false
+		m.saving = false
+		m.err = msg.err
+		m.parent.logger.Error("DedupeModel: erro", msg.err)
+		return m, nil
+
+	case duplicatesRemovedMsg:
+		m.saving = false
+		// recarrega para refletir o estado atual no YouTube
+		cmd := m.Init()
+		if msg.inPlace {
+			m.statusMessage = fmt.Sprintf("%d vídeos removidos da playlist no YouTube.", msg.count)
+		} else {
+			m.statusMessage = fmt.Sprintf("Cópia \"%s\" salva sem %d duplicatas.", msg.title, msg.count)
+		}
+		return m, cmd
+
+	case tea.KeyMsg:
+		if m.loading || m.saving {
+			return m, nil
+		}
+
+		if m.confirmingRemoval {
+			m.confirmingRemoval = false
+			if msg.String() != "y" {
+				m.statusMessage = "Remoção cancelada; nada foi alterado."
+				return m, nil
+			}
+			m.saving = true
+			m.err = nil
+			m.statusMessage = "Removendo duplicatas da playlist no YouTube. Aguarde... (Esc cancela)"
+			return m, m.removeCmd(true, "")
+		}
+
+		if m.awaitingTitle {
+			switch msg.Type {
+			case tea.KeyEnter:
+				m.awaitingTitle = false
+				title := strings.TrimSpace(m.newTitle)
+				if title == "" {
+					m.err = fmt.Errorf("título não pode ser vazio")
+					return m, nil
+				}
+				m.saving = true
+				m.err = nil
+				m.statusMessage = fmt.Sprintf("Salvando cópia \"%s\" no YouTube. Aguarde... (Esc cancela)", title)
+				return m, m.removeCmd(false, title)
+			case tea.KeyEsc:
+				m.awaitingTitle = false
+				m.err = nil
+				m.statusMessage = "Cópia cancelada; nada foi salvo."
+			case tea.KeyBackspace:
+				if len(m.newTitle) > 0 {
+					m.newTitle = m.newTitle[:len(m.newTitle)-1]
+				}
+			case tea.KeyRunes, tea.KeySpace:
+				m.newTitle += string(msg.Runes)
+			}
+			return m, nil
+		}
+
+		switch msg.Type {
+		case tea.KeyUp:
+			m.moveCursor(-1)
+		case tea.KeyDown:
+			m.moveCursor(1)
+		case tea.KeySpace:
+			if m.cursor < len(m.rows) && !m.rows[m.cursor].header {
+				pos := m.rows[m.cursor].position
+				m.selected[pos] = !m.selected[pos]
+			}
+		case tea.KeyBackspace:
+			return m, m.parent.send(showReorderMsg{playlist: m.playlist})
+		case tea.KeyRunes:
+			if len(m.selectedPositions()) == 0 {
+				return m, nil
+			}
+			switch string(msg.Runes) {
+			case "i":
+				// a remoção na própria playlist não tem volta pela aplicação: confirma antes
+				m.confirmingRemoval = true
+				m.err = nil
+			case "c":
+				m.awaitingTitle = true
+				m.newTitle = m.playlist.Title
+				m.err = nil
+			}
+		}
+	}
+
+	return m, nil
+}
+
+func (m *DedupeModel) View() string {
+	var b strings.Builder
+
+	b.WriteString(listHeaderStyle.Render(fmt.Sprintf("Duplicatas: %s", m.playlist.Title)))
+	b.WriteString("\n\n")
+
+	if m.loading {
+		b.WriteString("Procurando duplicatas…\n")
+		return docStyle.Render(b.String())
+	}
+
+	if m.saving {
+		b.WriteString("⏳ ")
+		b.WriteString(statusMessageStyle.Render(m.statusMessage))
+		b.WriteString("\n")
+		return docStyle.Render(b.String())
+	}
+
+	if m.awaitingTitle {
+		b.WriteString("Digite o título da cópia sem duplicatas e pressione Enter (Esc cancela):\n")
+		b.WriteString(listItemStyle.Render("> " + m.newTitle))
+		b.WriteString("\n\n")
+		if m.err != nil {
+			b.WriteString(errorMessageStyle.Render(fmt.Sprintf("Erro: %v", m.err)))
+			b.WriteString("\n")
+		}
+		return docStyle.Render(b.String())
+	}
+
+	if m.confirmingRemoval {
+		b.WriteString(statusMessageStyle.Render(fmt.Sprintf("Remover %d vídeos da playlist \"%s\" no YouTube?", len(m.selectedPositions()), m.playlist.Title)))
+		b.WriteString("\n\n")
+		b.WriteString(welcomePromptStyle.Render("y confirma, qualquer outra tecla cancela."))
+		return docStyle.Render(b.String())
+	}
+
+	start, end := visibleRange(m.cursor, len(m.rows), m.parent.height-12)
+	for i := start; i < end; i++ {
+		row := m.rows[i]
+		if row.header {
+			if row.kind == domain.DuplicateExact {
+				b.WriteString(welcomePromptStyle.Render("Duplicata exata (mesmo vídeo)"))
+			} else {
+				b.WriteString(welcomePromptStyle.Render("Quase duplicata (mesmo título e artista)"))
+			}
+			b.WriteString("\n")
+			continue
+		}
+
+		video := m.playlist.Videos[row.position]
+		mark := "[ ]"
+		if m.selected[row.position] {
+			mark = "[x]"
+		}
+		line := fmt.Sprintf("%s #%d %s — %s", mark, row.position+1, video.Title, video.Artist)
+		if i == m.cursor {
+			b.WriteString(selectedListItemStyle.Render(line))
+		} else {
+			b.WriteString(listItemStyle.Render(line))
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+
+	if m.statusMessage != "" {
+		b.WriteString(statusMessageStyle.Render(m.statusMessage))
+		b.WriteString("\n")
+	}
+	if m.err != nil {
+		b.WriteString(errorMessageStyle.Render(fmt.Sprintf("Erro: %v", m.err)))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(welcomePromptStyle.Render(fmt.Sprintf("%d vídeos marcados para remoção.", len(m.selectedPositions()))))
+	b.WriteString("\n")
+	b.WriteString(welcomePromptStyle.Render("Quase duplicatas não vêm marcadas. Espaço marca/desmarca, i remove na própria playlist (pede confirmação), c salva uma cópia, Backspace volta."))
+
+	return docStyle.Render(b.String())
+}
+
+type crossDuplicatesLoadedMsg struct {
+	report []domain.CrossPlaylistDuplicate
+}
+
+type CrossDuplicatesModel struct {
+	parent  *AppModel
+	report  []domain.CrossPlaylistDuplicate
+	cursor  int
+	loading bool
+	err     error
+}
+
+func NewCrossDuplicatesModel(parent *AppModel) *CrossDuplicatesModel {
+	return &CrossDuplicatesModel{
+		parent:  parent,
+		loading: true,
+	}
+}
+
+func (m *CrossDuplicatesModel) Init() tea.Cmd {
+	m.loading = true
+	m.err = nil
+	m.parent.logger.Info("CrossDuplicatesModel: buscando vídeos repetidos entre playlists…")
+
+	return func() tea.Msg {
+		ctx := m.parent.startJob("dedupe-cross")
+		report, err := m.parent.playlistUseCase.FindCrossPlaylistDuplicates(ctx)
+		err = m.parent.finishJob(ctx, err)
+		if err != nil {
+			return duplicatesErrorMsg{err: err}
+		}
+		return crossDuplicatesLoadedMsg{report: report}
+	}
+}
+
+func (m *CrossDuplicatesModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case crossDuplicatesLoadedMsg:
+		m.loading = false
+		m.report = msg.report
+		m.cursor = 0
+
+	case duplicatesErrorMsg:
+		m.loading = false
+		m.err = msg.err
+		m.parent.logger.Error("CrossDuplicatesModel: erro", msg.err)
+
+	case tea.KeyMsg:
+		switch msg.Type {
+		case tea.KeyUp:
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case tea.KeyDown:
+			if m.cursor < len(m.report)-1 {
+				m.cursor++
+			}
+		case tea.KeyBackspace:
+			return m, m.parent.send(showPlaylistsMsg{})
+		}
+	}
+
+	return m, nil
+}
+
+func (m *CrossDuplicatesModel) View() string {
+	var b strings.Builder
+
+	b.WriteString(listHeaderStyle.Render("Vídeos presentes em mais de uma playlist"))
+	b.WriteString("\n\n")
+
+	if m.loading {
+		b.WriteString("Carregando todas as playlists e vídeos… isso pode demorar (Esc cancela).\n")
+		return docStyle.Render(b.String())
+	}
+
+	if m.err != nil {
+		b.WriteString(errorMessageStyle.Render(fmt.Sprintf("Erro: %v", m.err)))
+		b.WriteString("\n\n")
+		b.WriteString(welcomePromptStyle.Render("Backspace para voltar."))
+		return docStyle.Render(b.String())
+	}
+
+	if len(m.report) == 0 {
+		b.WriteString(statusMessageStyle.Render("Nenhum vídeo aparece em mais de uma playlist."))
+		b.WriteString("\n")
+	}
+
+	start, end := visibleRange(m.cursor, len(m.report), (m.parent.height-8)/2)
+	for i := start; i < end; i++ {
+		entry := m.report[i]
+		line := fmt.Sprintf("%s — %s (%d playlists)", entry.Video.Title, entry.Video.Artist, len(entry.PlaylistIDs))
+		if i == m.cursor {
+			b.WriteString(selectedListItemStyle.Render(line))
+		} else {
+			b.WriteString(listItemStyle.Render(line))
+		}
+		b.WriteString("\n")
+		b.WriteString(listItemStyle.Render("    " + welcomePromptStyle.Render(strings.Join(entry.PlaylistTitles, ", "))))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(welcomePromptStyle.Render("Use ↑/↓ para navegar, Backspace para voltar."))
+
+	return docStyle.Render(b.String())
+}
+
+// visibleRange calcula a janela de linhas que cabe na tela mantendo o cursor visível.
+func visibleRange(cursor, total, height int) (int, int) {
+	if height <= 0 || total <= height {
+		return 0, total
+	}
+
+	start := cursor - height/2
+	if start < 0 {
+		start = 0
+	}
+	end := start + height
+	if end > total {
+		end = total
+		start = end - height
+	}
+
+	return start, end
+}
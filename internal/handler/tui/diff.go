@@ -0,0 +1,282 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"TUI_playlist_reorder/internal/core/domain"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type diffTargetsLoadedMsg struct {
+	snapshots []domain.Snapshot
+	playlists []domain.Playlist
+}
+type diffComputedMsg struct {
+	diff  domain.PlaylistDiff
+	label string
+}
+type snapshotSavedMsg struct{ snapshot domain.Snapshot }
+type diffErrorMsg struct{ err error }
+
+// diffTarget é uma opção de comparação: um snapshot local ou outra playlist.
+type diffTarget struct {
+	label      string
+	snapshotID string
+	playlistID string
+}
+
+type DiffModel struct {
+	parent   *AppModel
+	playlist domain.Playlist
+
+	targets []diffTarget
+	cursor  int
+
+	diff      *domain.PlaylistDiff
+	diffLabel string
+	lines     []string
+	scroll    int
+
+	loading       bool
+	statusMessage string
+	err           error
+}
+
+func NewDiffModel(parent *AppModel, playlist domain.Playlist) *DiffModel {
+	return &DiffModel{
+		parent:   parent,
+		playlist: playlist,
+		loading:  true,
+	}
+}
+
+func (m *DiffModel) Init() tea.Cmd {
+	m.loading = true
+	m.err = nil
+	m.diff = nil
+	m.parent.logger.Info("DiffModel: carregando opções de comparação", "playlist_id", m.playlist.ID, "title", m.playlist.Title)
+
+	playlistID := m.playlist.ID
+	return func() tea.Msg {
+		snapshots, err := m.parent.playlistUseCase.ListSnapshots(playlistID)
+		if err != nil {
+			return diffErrorMsg{err: err}
+		}
+		ctx := m.parent.startJob("fetch")
+		playlists, err := m.parent.playlistUseCase.GetMinePlaylists(ctx)
+		err = m.parent.finishJob(ctx, err)
+		if err != nil {
+			return diffErrorMsg{err: err}
+		}
+		return diffTargetsLoadedMsg{snapshots: snapshots, playlists: playlists}
+	}
+}
+
+func (m *DiffModel) computeCmd(target diffTarget) tea.Cmd {
+	playlistID := m.playlist.ID
+	return func() tea.Msg {
+		if target.snapshotID != "" {
+			ctx := m.parent.startJob("diff")
+			diff, _, err := m.parent.playlistUseCase.DiffWithSnapshot(ctx, playlistID, target.snapshotID)
+			err = m.parent.finishJob(ctx, err)
+			if err != nil {
+				return diffErrorMsg{err: err}
+			}
+			return diffComputedMsg{diff: diff, label: target.label}
+		}
+
+		ctx := m.parent.startJob("diff")
+		diff, err := m.parent.playlistUseCase.DiffPlaylists(ctx, playlistID, target.playlistID)
+		err = m.parent.finishJob(ctx, err)
+		if err != nil {
+			return diffErrorMsg{err: err}
+		}
+		return diffComputedMsg{diff: diff, label: target.label}
+	}
+}
+
+func (m *DiffModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case diffTargetsLoadedMsg:
+		m.loading = false
+		m.targets = nil
+		for _, snapshot := range msg.snapshots {
+			m.targets = append(m.targets, diffTarget{
+				label:      "Snapshot de " + snapshot.TakenAt.Local().Format(time.DateTime),
+				snapshotID: snapshot.ID,
+			})
+		}
+		for _, playlist := range msg.playlists {
+			if playlist.ID == m.playlist.ID {
+				continue
+			}
+			m.targets = append(m.targets, diffTarget{label: playlist.Title, playlistID: playlist.ID})
+		}
+		m.cursor = 0
+		return m, nil
+
+	case diffComputedMsg:
+		m.loading = false
+		m.diff = &msg.diff
+		m.diffLabel = msg.label
+		m.lines = diffLines(msg.diff)
+		m.scroll = 0
+		return m, nil
+
+	case snapshotSavedMsg:
+		m.statusMessage = fmt.Sprintf("Snapshot salvo com %d vídeos.", len(msg.snapshot.Playlist.Videos))
+		cmd := m.Init()
+		return m, cmd
+
+	case diffErrorMsg:
+		m.loading = false
+		m.err = msg.err
+		m.parent.logger.Error("DiffModel: erro", msg.err)
+		return m, nil
+
+	case tea.KeyMsg:
+		if m.loading {
+			return m, nil
+		}
+
+		// Tela de resultado: apenas rolagem
+		if m.diff != nil {
+			switch msg.Type {
+			case tea.KeyUp:
+				if m.scroll > 0 {
+					m.scroll--
+				}
+			case tea.KeyDown:
+				if m.scroll < len(m.lines)-1 {
+					m.scroll++
+				}
+			case tea.KeyBackspace:
+				m.diff = nil
+			}
+			return m, nil
+		}
+
+		switch msg.Type {
+		case tea.KeyUp:
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case tea.KeyDown:
+			if m.cursor < len(m.targets)-1 {
+				m.cursor++
+			}
+		case tea.KeyEnter:
+			if len(m.targets) == 0 {
+				return m, nil
+			}
+			m.loading = true
+			m.err = nil
+			return m, m.computeCmd(m.targets[m.cursor])
+		case tea.KeyBackspace:
+			return m, m.parent.send(showReorderMsg{playlist: m.playlist})
+		case tea.KeyRunes:
+			if string(msg.Runes) == "s" {
+				m.loading = true
+				m.err = nil
+				playlistID := m.playlist.ID
+				return m, func() tea.Msg {
+					ctx := m.parent.startJob("snapshot")
+					snapshot, err := m.parent.playlistUseCase.TakeSnapshot(ctx, playlistID)
+					err = m.parent.finishJob(ctx, err)
+					if err != nil {
+						return diffErrorMsg{err: err}
+					}
+					return snapshotSavedMsg{snapshot: snapshot}
+				}
+			}
+		}
+	}
+
+	return m, nil
+}
+
+func diffLines(diff domain.PlaylistDiff) []string {
+	var lines []string
+
+	lines = append(lines, welcomePromptStyle.Render(fmt.Sprintf("Somente em \"%s\" (%d):", diff.A.Title, len(diff.OnlyInA))))
+	for _, video := range diff.OnlyInA {
+		lines = append(lines, errorMessageStyle.Render(fmt.Sprintf("  - %s — %s", video.Title, video.Artist)))
+	}
+
+	lines = append(lines, "", welcomePromptStyle.Render(fmt.Sprintf("Somente em \"%s\" (%d):", diff.B.Title, len(diff.OnlyInB))))
+	for _, video := range diff.OnlyInB {
+		lines = append(lines, statusMessageStyle.Render(fmt.Sprintf("  + %s — %s", video.Title, video.Artist)))
+	}
+
+	lines = append(lines, "", welcomePromptStyle.Render(fmt.Sprintf("Em ambas (%d, %d mudaram de posição):", len(diff.InBoth), diff.MovedCount())))
+	for _, entry := range diff.InBoth {
+		move := "="
+		switch moved := entry.Moved(); {
+		case moved < 0:
+			move = fmt.Sprintf("↑%d", -moved)
+		case moved > 0:
+			move = fmt.Sprintf("↓%d", moved)
+		}
+		lines = append(lines, fmt.Sprintf("  #%-4d → #%-4d %-5s %s", entry.PositionA+1, entry.PositionB+1, move, entry.Video.Title))
+	}
+
+	return lines
+}
+
+func (m *DiffModel) View() string {
+	var b strings.Builder
+
+	b.WriteString(listHeaderStyle.Render(fmt.Sprintf("Comparar Playlist: %s", m.playlist.Title)))
+	b.WriteString("\n\n")
+
+	if m.loading {
+		b.WriteString("Carregando…\n")
+		return docStyle.Render(b.String())
+	}
+
+	if m.err != nil {
+		b.WriteString(errorMessageStyle.Render(fmt.Sprintf("Erro: %v", m.err)))
+		b.WriteString("\n\n")
+	}
+
+	if m.diff != nil {
+		b.WriteString(fmt.Sprintf("Comparando com: %s\n\n", m.diffLabel))
+		start, end := visibleRange(m.scroll, len(m.lines), m.parent.height-10)
+		for _, line := range m.lines[start:end] {
+			b.WriteString(line)
+			b.WriteString("\n")
+		}
+		b.WriteString("\n")
+		b.WriteString(welcomePromptStyle.Render("Use ↑/↓ para rolar, Backspace para escolher outra comparação."))
+		return docStyle.Render(b.String())
+	}
+
+	b.WriteString("Comparar com:\n")
+	if len(m.targets) == 0 {
+		b.WriteString(welcomePromptStyle.Render("Nenhum snapshot ou outra playlist disponível. Pressione s para salvar um snapshot."))
+		b.WriteString("\n")
+	}
+
+	start, end := visibleRange(m.cursor, len(m.targets), m.parent.height-12)
+	for i := start; i < end; i++ {
+		if i == m.cursor {
+			b.WriteString(selectedListItemStyle.Render(m.targets[i].label))
+		} else {
+			b.WriteString(listItemStyle.Render(m.targets[i].label))
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+
+	if m.statusMessage != "" {
+		b.WriteString(statusMessageStyle.Render(m.statusMessage))
+		b.WriteString("\n\n")
+	}
+
+	b.WriteString(welcomePromptStyle.Render("Enter compara, s salva um snapshot do estado atual, Backspace volta."))
+
+	return docStyle.Render(b.String())
+}
@@ -0,0 +1,220 @@
+package tui
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// destaque das letras encontradas pela busca: negrito e sublinhado ligados e desligados sem
+// reset, para não apagar a cor da linha selecionada em volta
+const (
+	matchHighlightOn  = "\x1b[1;4m"
+	matchHighlightOff = "\x1b[22;24m"
+)
+
+// acentos ignorados na busca, para "musica" encontrar "Música"
+var fuzzyFold = map[rune]rune{
+	'á': 'a', 'à': 'a', 'â': 'a', 'ã': 'a', 'ä': 'a',
+	'é': 'e', 'è': 'e', 'ê': 'e', 'ë': 'e',
+	'í': 'i', 'ì': 'i', 'î': 'i', 'ï': 'i',
+	'ó': 'o', 'ò': 'o', 'ô': 'o', 'õ': 'o', 'ö': 'o',
+	'ú': 'u', 'ù': 'u', 'û': 'u', 'ü': 'u',
+	'ç': 'c', 'ñ': 'n',
+}
+
+func foldRunes(s string) []rune {
+	runes := []rune(s)
+	for i, r := range runes {
+		r = unicode.ToLower(r)
+		if folded, ok := fuzzyFold[r]; ok {
+			r = folded
+		}
+		runes[i] = r
+	}
+	return runes
+}
+
+// fuzzyMatch procura as letras de pattern, em ordem, dentro de text (como no fzf), sem
+// diferenciar maiúsculas nem acentos. Devolve a pontuação da melhor ocorrência e as posições
+// (índices de rune em text) das letras encontradas.
+func fuzzyMatch(pattern, text string) (int, []int, bool) {
+	p, t := foldRunes(pattern), foldRunes(text)
+	if len(p) == 0 {
+		return 0, nil, true
+	}
+
+	found := false
+	best := 0
+	var bestPositions []int
+	for start := range t {
+		if t[start] != p[0] {
+			continue
+		}
+		positions := []int{start}
+		for i := start + 1; i < len(t) && len(positions) < len(p); i++ {
+			if t[i] == p[len(positions)] {
+				positions = append(positions, i)
+			}
+		}
+		// se não coube a partir daqui, também não cabe começando mais à frente
+		if len(positions) < len(p) {
+			break
+		}
+		if score := fuzzyScore(t, positions); !found || score > best {
+			found, best, bestPositions = true, score, positions
+		}
+	}
+	return best, bestPositions, found
+}
+
+// fuzzyScore favorece letras seguidas e no começo de palavras e penaliza os saltos.
+func fuzzyScore(text []rune, positions []int) int {
+	score := 0
+	for i, pos := range positions {
+		score++
+		if pos == 0 || !(unicode.IsLetter(text[pos-1]) || unicode.IsDigit(text[pos-1])) {
+			score += 8
+		}
+		if i > 0 {
+			if gap := pos - positions[i-1] - 1; gap == 0 {
+				score += 5
+			} else {
+				score -= min(gap, 3)
+			}
+		}
+	}
+	return score - min(positions[0], 10)/2
+}
+
+// fuzzyMatchFields aplica a busca a vários campos (título e artista, por exemplo). Cada
+// palavra do padrão precisa aparecer em algum campo; as posições voltam por campo.
+func fuzzyMatchFields(pattern string, fields ...string) (int, [][]int, bool) {
+	positions := make([][]int, len(fields))
+	total := 0
+	for _, term := range strings.Fields(pattern) {
+		found := false
+		best, bestField := 0, 0
+		var bestPositions []int
+		for i, field := range fields {
+			if score, pos, ok := fuzzyMatch(term, field); ok && (!found || score > best) {
+				found, best, bestField, bestPositions = true, score, i, pos
+			}
+		}
+		if !found {
+			return 0, nil, false
+		}
+		total += best
+		positions[bestField] = append(positions[bestField], bestPositions...)
+	}
+	return total, positions, true
+}
+
+// highlightMatches destaca em text as runes nas posições encontradas pela busca.
+func highlightMatches(text string, positions []int) string {
+	if len(positions) == 0 {
+		return text
+	}
+	matched := make(map[int]bool, len(positions))
+	for _, pos := range positions {
+		matched[pos] = true
+	}
+
+	var b strings.Builder
+	on := false
+	for i, r := range []rune(text) {
+		if matched[i] != on {
+			on = matched[i]
+			if on {
+				b.WriteString(matchHighlightOn)
+			} else {
+				b.WriteString(matchHighlightOff)
+			}
+		}
+		b.WriteRune(r)
+	}
+	if on {
+		b.WriteString(matchHighlightOff)
+	}
+	return b.String()
+}
+
+// highlightCell destaca as posições dentro de uma célula da tabela. A tabela do bubbles
+// corta as células contando os bytes dos códigos de destaque como largura visível, então o
+// texto é encurtado aqui, com "…", até caber junto com eles.
+func highlightCell(text string, positions []int, width int) string {
+	if len(positions) == 0 {
+		return text
+	}
+
+	runes := []rune(text)
+	for n := len(runes); n > 0; n-- {
+		visible := string(runes[:n])
+		if n < len(runes) {
+			visible += "…"
+		}
+		var kept []int
+		for _, pos := range positions {
+			if pos < n {
+				kept = append(kept, pos)
+			}
+		}
+		cell := highlightMatches(visible, kept)
+		if lipgloss.Width(visible)+len(cell)-len(visible) <= width {
+			return cell
+		}
+	}
+	return text
+}
+
+// searchBox é a busca incremental aberta com /: o filtro muda a cada tecla, Enter fecha a
+// caixa mantendo o filtro (vazio limpa), Backspace com a caixa vazia fecha e limpa e Esc
+// fecha voltando ao filtro de antes da caixa abrir.
+type searchBox struct {
+	input    textinput.Model
+	active   bool
+	previous string
+}
+
+func (s *searchBox) open(current string) tea.Cmd {
+	s.active = true
+	s.previous = current
+	s.input = textinput.New()
+	s.input.Prompt = "/"
+	s.input.Placeholder = "buscar"
+	s.input.Width = 40
+	s.input.SetValue(current)
+	s.input.CursorEnd()
+	return s.input.Focus()
+}
+
+// update trata uma tecla com a caixa aberta e devolve o filtro a aplicar.
+func (s *searchBox) update(msg tea.KeyMsg) (string, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEnter:
+		s.active = false
+		s.input.Blur()
+		return strings.TrimSpace(s.input.Value()), nil
+	case tea.KeyEsc:
+		s.active = false
+		s.input.Blur()
+		return s.previous, nil
+	case tea.KeyBackspace:
+		if s.input.Value() == "" {
+			s.active = false
+			s.input.Blur()
+			return "", nil
+		}
+	}
+
+	var cmd tea.Cmd
+	s.input, cmd = s.input.Update(msg)
+	return strings.TrimSpace(s.input.Value()), cmd
+}
+
+func (s searchBox) View() string {
+	return s.input.View()
+}
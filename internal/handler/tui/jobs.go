@@ -0,0 +1,151 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"TUI_playlist_reorder/internal/core/domain"
+)
+
+// runningJob é uma busca ou gravação em andamento, que Esc cancela; uma gravação que já
+// começou a alterar o YouTube para antes da escrita seguinte (ver domain.BeginWrites).
+type runningJob struct {
+	operation domain.Operation
+	started   time.Time
+	cancel    context.CancelFunc
+	writing   atomic.Bool
+}
+
+// jobRegistry guarda as operações remotas em andamento; elas começam e terminam dentro dos
+// tea.Cmd, fora da goroutine do Update.
+type jobRegistry struct {
+	mu      sync.Mutex
+	running map[string]*runningJob
+}
+
+// jobStoppedError substitui o erro (geralmente longo, vindo do cliente HTTP) de uma
+// operação cancelada ou com prazo esgotado; o original continua acessível por Unwrap.
+type jobStoppedError struct {
+	status      domain.JobStatus
+	operationID string
+	err         error
+}
+
+func (e *jobStoppedError) Error() string {
+	if e.status == domain.JobTimedOut {
+		return fmt.Sprintf("prazo esgotado [op %s]", e.operationID)
+	}
+	return fmt.Sprintf("operação cancelada [op %s]", e.operationID)
+}
+
+func (e *jobStoppedError) Unwrap() error {
+	return e.err
+}
+
+// jobStopped diz se o erro é de uma operação cancelada com Esc ou com prazo esgotado.
+func jobStopped(err error) bool {
+	var stopped *jobStoppedError
+	return errors.As(err, &stopped)
+}
+
+// startJob abre uma operação remota cancelável com Esc e limitada pelo job_timeout_minutes,
+// registrando o início no diário de operações. Toda chamada precisa de um finishJob.
+func (m *AppModel) startJob(name string) context.Context {
+	ctx := m.operation(name)
+	var cancel context.CancelFunc
+	if m.settings.JobTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, m.settings.JobTimeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+
+	op, _ := domain.OperationFrom(ctx)
+	job := &runningJob{operation: op, started: time.Now(), cancel: cancel}
+	ctx = domain.OnWritesStarted(ctx, func() { job.writing.Store(true) })
+
+	m.jobs.mu.Lock()
+	if m.jobs.running == nil {
+		m.jobs.running = make(map[string]*runningJob)
+	}
+	m.jobs.running[op.ID] = job
+	m.jobs.mu.Unlock()
+
+	m.recordJob(domain.JobEvent{OperationID: op.ID, Name: op.Name, Status: domain.JobStarted, At: job.started})
+	return ctx
+}
+
+// finishJob registra o resultado da operação aberta por startJob e devolve o erro a mostrar.
+func (m *AppModel) finishJob(ctx context.Context, err error) error {
+	op, _ := domain.OperationFrom(ctx)
+
+	m.jobs.mu.Lock()
+	job, ok := m.jobs.running[op.ID]
+	delete(m.jobs.running, op.ID)
+	m.jobs.mu.Unlock()
+	if !ok {
+		return err
+	}
+	job.cancel()
+
+	// o erro do provider nem sempre embrulha o do contexto; o estado do contexto decide
+	status := domain.JobStatusFor(err)
+	if err != nil && ctx.Err() != nil {
+		status = domain.JobStatusFor(ctx.Err())
+	}
+
+	event := domain.JobEvent{OperationID: op.ID, Name: op.Name, Status: status, At: time.Now(), Duration: time.Since(job.started)}
+	if err != nil {
+		event.Err = err.Error()
+	}
+	m.recordJob(event)
+
+	switch status {
+	case domain.JobCancelled, domain.JobTimedOut:
+		m.logger.WarningContext(ctx, "Operação interrompida", "status", status, "duration_ms", event.Duration.Milliseconds())
+		return &jobStoppedError{status: status, operationID: op.ID, err: err}
+	}
+	return err
+}
+
+// cancelJobs cancela as operações remotas em andamento e conta, entre elas, as que já
+// gravavam no YouTube e param depois da escrita atual.
+func (m *AppModel) cancelJobs() (cancelled, writing int) {
+	m.jobs.mu.Lock()
+	defer m.jobs.mu.Unlock()
+
+	for _, job := range m.jobs.running {
+		m.logger.Info("Cancelando operação", "op_id", job.operation.ID, "op_name", job.operation.Name, "writing", job.writing.Load())
+		job.cancel()
+		cancelled++
+		if job.writing.Load() {
+			writing++
+		}
+	}
+	return cancelled, writing
+}
+
+// writingJobs diz se alguma operação está gravando no YouTube.
+func (m *AppModel) writingJobs() bool {
+	m.jobs.mu.Lock()
+	defer m.jobs.mu.Unlock()
+
+	for _, job := range m.jobs.running {
+		if job.writing.Load() {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *AppModel) recordJob(event domain.JobEvent) {
+	if m.journal == nil {
+		return
+	}
+	if err := m.journal.Record(event); err != nil {
+		m.logger.Warning("Falha ao gravar no diário de operações", "op_id", event.OperationID, "err", err)
+	}
+}
@@ -0,0 +1,338 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"TUI_playlist_reorder/infrastructure/logger"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	logViewerMaxEntries = 1000
+	logViewerPoll       = time.Second
+)
+
+// níveis percorridos pela tecla l, do mais detalhado ao mais grave
+var logViewerLevels = []string{"TRACE", "DEBUG", "INFO", "WARNING", "ERROR"}
+
+var logLevelStyles = map[string]lipgloss.Style{
+	"TRACE":   lipgloss.NewStyle().Foreground(lipgloss.Color("240")),
+	"DEBUG":   lipgloss.NewStyle().Foreground(lipgloss.Color("39")),
+	"INFO":    lipgloss.NewStyle().Foreground(lipgloss.Color("#04B575")),
+	"WARNING": lipgloss.NewStyle().Foreground(lipgloss.Color("214")),
+	"ERROR":   lipgloss.NewStyle().Foreground(lipgloss.Color("9")).Bold(true),
+}
+
+type logTickMsg struct{}
+type logLinesMsg struct {
+	path    string
+	entries []logger.LogData
+	offset  int64
+	err     error
+}
+
+// LogViewerModel acompanha o arquivo de log atual (como um tail -f) por cima da tela em
+// uso, que continua recebendo as próprias mensagens enquanto o painel está aberto.
+type LogViewerModel struct {
+	parent *AppModel
+
+	path    string
+	offset  int64
+	entries []logger.LogData
+
+	minLevel  int // índice em logViewerLevels
+	search    string
+	searching bool
+	input     textinput.Model
+
+	cursor   int  // índice em visible()
+	follow   bool // cursor preso na última linha enquanto chegam linhas novas
+	expanded bool // mostra todos os atributos da linha selecionada
+	err      error
+
+	open    bool
+	polling bool
+}
+
+func NewLogViewerModel(parent *AppModel) *LogViewerModel {
+	return &LogViewerModel{parent: parent, minLevel: 0, follow: true}
+}
+
+// Toggle abre ou fecha o painel. Fechado, a leitura periódica para no próximo tick; as
+// linhas já lidas e os filtros são mantidos para a próxima abertura.
+func (m *LogViewerModel) Toggle() tea.Cmd {
+	m.open = !m.open
+	m.searching = false
+	if !m.open || m.polling {
+		return nil
+	}
+	m.polling = true
+	m.follow = true
+	return m.Init()
+}
+
+// Init começa a acompanhar o arquivo atual; chamado por Toggle ao abrir o painel.
+func (m *LogViewerModel) Init() tea.Cmd {
+	return m.readCmd()
+}
+
+// readCmd lê o que foi gravado desde a última leitura; depois da rotação o arquivo muda e a
+// leitura recomeça do início do arquivo novo.
+func (m *LogViewerModel) readCmd() tea.Cmd {
+	path := m.parent.logger.CurrentFile()
+	offset := m.offset
+	if path != m.path {
+		offset = 0
+	}
+
+	return func() tea.Msg {
+		if path == "" {
+			return logLinesMsg{path: path}
+		}
+		entries, next, err := logger.TailFile(path, offset)
+		return logLinesMsg{path: path, entries: entries, offset: next, err: err}
+	}
+}
+
+func (m *LogViewerModel) tick() tea.Cmd {
+	return tea.Tick(logViewerPoll, func(time.Time) tea.Msg { return logTickMsg{} })
+}
+
+func (m *LogViewerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case logTickMsg:
+		if !m.open {
+			m.polling = false
+			return m, nil
+		}
+		return m, m.readCmd()
+
+	case logLinesMsg:
+		m.err = msg.err
+		m.path = msg.path
+		m.offset = msg.offset
+		m.entries = append(m.entries, msg.entries...)
+		if len(m.entries) > logViewerMaxEntries {
+			m.entries = m.entries[len(m.entries)-logViewerMaxEntries:]
+		}
+		m.clampCursor()
+		return m, m.tick()
+
+	case tea.KeyMsg:
+		if m.searching {
+			return m.updateSearch(msg)
+		}
+
+		visible := m.visible()
+		switch msg.Type {
+		case tea.KeyUp:
+			if m.cursor > 0 {
+				m.cursor--
+				m.follow = false
+			}
+		case tea.KeyDown:
+			if m.cursor < len(visible)-1 {
+				m.cursor++
+			}
+			m.follow = m.cursor == len(visible)-1
+		case tea.KeyPgUp:
+			m.cursor = max(m.cursor-m.pageSize(), 0)
+			m.follow = false
+		case tea.KeyPgDown:
+			m.cursor = min(m.cursor+m.pageSize(), max(len(visible)-1, 0))
+			m.follow = m.cursor == len(visible)-1
+		case tea.KeyEnter:
+			m.expanded = !m.expanded
+		case tea.KeyRunes:
+			switch string(msg.Runes) {
+			case "l":
+				m.minLevel = (m.minLevel + 1) % len(logViewerLevels)
+				m.follow = true
+				m.clampCursor()
+			case "/":
+				m.searching = true
+				m.input = textinput.New()
+				m.input.Prompt = "/"
+				m.input.SetValue(m.search)
+				m.input.CursorEnd()
+				m.input.Width = 50
+				return m, m.input.Focus()
+			case "g":
+				m.cursor = 0
+				m.follow = false
+			case "G":
+				m.follow = true
+				m.clampCursor()
+			}
+		}
+	}
+
+	return m, nil
+}
+
+func (m *LogViewerModel) updateSearch(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEnter:
+		m.searching = false
+		m.search = strings.TrimSpace(m.input.Value())
+		m.follow = true
+		m.clampCursor()
+		return m, nil
+	case tea.KeyEsc:
+		m.searching = false
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	return m, cmd
+}
+
+// visible aplica o filtro de nível e a busca (sem diferenciar maiúsculas) sobre mensagem,
+// erro, componente, operação e atributos.
+func (m *LogViewerModel) visible() []logger.LogData {
+	minLevel := logger.LevelValue(logViewerLevels[m.minLevel])
+	search := strings.ToLower(m.search)
+
+	var visible []logger.LogData
+	for _, entry := range m.entries {
+		if entry.Level != "" && logger.LevelValue(entry.Level) < minLevel {
+			continue
+		}
+		if search != "" && !strings.Contains(strings.ToLower(logSearchText(entry)), search) {
+			continue
+		}
+		visible = append(visible, entry)
+	}
+	return visible
+}
+
+func logSearchText(entry logger.LogData) string {
+	parts := []string{entry.Message, entry.Err, entry.Component, entry.Operation.ID, entry.Operation.Name}
+	for key, value := range entry.Attrs {
+		parts = append(parts, fmt.Sprintf("%s=%v", key, value))
+	}
+	return strings.Join(parts, " ")
+}
+
+func (m *LogViewerModel) clampCursor() {
+	last := len(m.visible()) - 1
+	if m.follow || m.cursor > last {
+		m.cursor = last
+	}
+	if m.cursor < 0 {
+		m.cursor = 0
+	}
+}
+
+// pageSize é quantas linhas cabem no painel, descontando cabeçalho e rodapé.
+func (m *LogViewerModel) pageSize() int {
+	if m.parent.height <= 0 {
+		return 15
+	}
+	return max(m.parent.height-10, 3)
+}
+
+func (m *LogViewerModel) View() string {
+	var b strings.Builder
+
+	b.WriteString(listHeaderStyle.Render("Logs — " + m.path))
+	b.WriteString("\n")
+
+	filter := "nível ≥ " + logViewerLevels[m.minLevel]
+	if m.search != "" {
+		filter += fmt.Sprintf(", busca \"%s\"", m.search)
+	}
+	visible := m.visible()
+	b.WriteString(welcomePromptStyle.Render(fmt.Sprintf("%s — %d de %d linhas", filter, len(visible), len(m.entries))))
+	b.WriteString("\n\n")
+
+	if m.err != nil {
+		b.WriteString(errorMessageStyle.Render(fmt.Sprintf("Falha ao ler o log: %v", m.err)))
+		b.WriteString("\n\n")
+	}
+
+	// janela de linhas que termina no cursor (ou começa nele, perto do início)
+	size := m.pageSize()
+	if m.expanded {
+		size = max(size/2, 1)
+	}
+	start := max(m.cursor-size+1, 0)
+	end := min(start+size, len(visible))
+
+	for i := start; i < end; i++ {
+		b.WriteString(m.renderEntry(visible[i], i == m.cursor))
+	}
+	if len(visible) == 0 {
+		b.WriteString(welcomePromptStyle.Render("Nenhuma linha com o filtro atual."))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	if m.searching {
+		b.WriteString(m.input.View())
+		b.WriteString("\n")
+		b.WriteString(welcomePromptStyle.Render("Enter aplica a busca (vazia limpa), Esc cancela."))
+		return docStyle.Render(b.String())
+	}
+
+	status := "seguindo o arquivo"
+	if !m.follow {
+		status = "pausado (G volta a seguir)"
+	}
+	b.WriteString(welcomePromptStyle.Render(fmt.Sprintf("↑/↓ PgUp/PgDn navegam, Enter detalha, l muda o nível, / busca, g/G início/fim — %s. Ctrl+L ou Esc fecha.", status)))
+	return docStyle.Render(b.String())
+}
+
+// renderEntry formata uma linha do log: horário, nível colorido, componente e mensagem; o
+// erro vem destacado logo abaixo e, na linha selecionada com Enter, todos os atributos.
+func (m *LogViewerModel) renderEntry(entry logger.LogData, selected bool) string {
+	var b strings.Builder
+
+	prefix := "   "
+	if selected {
+		prefix = selectedListItemStyle.String()
+	}
+
+	levelStyle, ok := logLevelStyles[entry.Level]
+	if !ok {
+		levelStyle = lipgloss.NewStyle()
+	}
+
+	header := entry.Message
+	if entry.Level != "" {
+		header = fmt.Sprintf("%s %s [%s] %s", entry.Time.Local().Format("15:04:05.000"),
+			levelStyle.Render(fmt.Sprintf("%-7s", entry.Level)), entry.Component, entry.Message)
+	}
+	b.WriteString(prefix + header + "\n")
+
+	if entry.Err != "" {
+		b.WriteString("    " + errorMessageStyle.Render("err: "+entry.Err) + "\n")
+	}
+
+	if !(selected && m.expanded) {
+		return b.String()
+	}
+
+	if entry.Operation.ID != "" {
+		b.WriteString(welcomePromptStyle.Render(fmt.Sprintf("    op: %s (%s)", entry.Operation.ID, entry.Operation.Name)) + "\n")
+	}
+	keys := make([]string, 0, len(entry.Attrs))
+	for key := range entry.Attrs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		b.WriteString(welcomePromptStyle.Render(fmt.Sprintf("    %s: %v", key, entry.Attrs[key])) + "\n")
+	}
+	if entry.Source != nil {
+		b.WriteString(welcomePromptStyle.Render(fmt.Sprintf("    origem: %s:%d (%s)", entry.Source.File, entry.Source.Line, entry.Source.Function)) + "\n")
+	}
+	return b.String()
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"golang.org/x/oauth2"
+	"net"
 	"strings"
 	"time"
 
@@ -11,11 +12,16 @@ import (
 	"TUI_playlist_reorder/infrastructure/logger"
 	"TUI_playlist_reorder/internal/handler/server"
 
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/pkg/browser"
 )
 
-type authURLGeneratedMsg struct{ url string }
+type authURLGeneratedMsg struct {
+	attempt  auth.LoginAttempt
+	listener net.Listener
+}
+type deviceCodeMsg struct{ device *oauth2.DeviceAuthResponse }
 type authSuccessMsg struct {
 	token *oauth2.Token
 	code  string
@@ -28,18 +34,31 @@ const (
 	loginIdle loginState = iota
 	loginAuthURLGenerated
 	loginWaitingForCallback
+	loginWaitingForDevice
 	loginExchangingToken
 	loginSuccess
 	loginError
 )
 
+// Métodos de login (config login_method, já resolvido a partir de "auto")
+const (
+	loginMethodBrowser = "browser"
+	loginMethodManual  = "manual"
+	loginMethodDevice  = "device"
+)
+
 type LoginModel struct {
 	parent           *AppModel
 	state            loginState
+	method           string
 	authURL          string
 	errorMsg         string
 	statusMsg        string
-	csrfState        string
+	attempt          auth.LoginAttempt
+	device           *oauth2.DeviceAuthResponse
+	pasting          bool
+	input            textinput.Model
+	loginCtx         context.Context // operação "login", mantida até a troca do código
 	httpServerCtx    context.Context
 	httpServerCancel context.CancelFunc
 }
@@ -57,15 +76,32 @@ func (m *LoginModel) Init() tea.Cmd {
 	m.state = loginIdle
 	m.errorMsg = ""
 	m.statusMsg = "Pressione Enter para iniciar o login com Google..."
-	m.csrfState = fmt.Sprintf("st%d", time.Now().UnixNano())
+	m.attempt = auth.LoginAttempt{}
+	m.device = nil
+	m.pasting = false
 	return nil
 }
 
-// → Gera URL de autenticação (executado em goroutine separada)
-func generateAuthURLCmd(authService auth.AuthenticationService, state string) tea.Cmd {
+// → Abre o servidor de callback (porta efêmera por padrão) e gera state, PKCE e URL de
+// autenticação com o redirect URI da porta obtida. Falhas de bind voltam como erro na hora.
+func startLoginCmd(
+	authService auth.AuthenticationService,
+	callbackHandler server.CallbackHandler,
+	addr string,
+	callbackPath string,
+) tea.Cmd {
 	return func() tea.Msg {
-		url := authService.GenerateAuthURL(state)
-		return authURLGeneratedMsg{url: url}
+		listener, err := callbackHandler.Listen(addr)
+		if err != nil {
+			return authErrorMsg{err: err}
+		}
+
+		attempt, err := authService.NewLoginAttempt(server.RedirectURL(addr, listener, callbackPath))
+		if err != nil {
+			_ = listener.Close()
+			return authErrorMsg{err: err}
+		}
+		return authURLGeneratedMsg{attempt: attempt, listener: listener}
 	}
 }
 
@@ -73,8 +109,8 @@ func generateAuthURLCmd(authService auth.AuthenticationService, state string) te
 func waitForCallbackCmd(
 	ctx context.Context,
 	callbackHandler server.CallbackHandler,
+	listener net.Listener,
 	expectedState string,
-	addr string,
 	callbackPath string,
 	logger logger.Logger,
 ) tea.Cmd {
@@ -84,13 +120,12 @@ func waitForCallbackCmd(
 		srvCtx, srvCancel := context.WithCancel(ctx)
 		defer srvCancel()
 
-		logger.Info(fmt.Sprintf("Iniciando servidor de callback em %s, estado esperado: %s", addr, expectedState))
-		_ = callbackHandler.ListenAndServe(srvCtx, expectedState, addr, callbackPath, resultChan)
+		_ = callbackHandler.Serve(srvCtx, listener, expectedState, callbackPath, resultChan)
 
-		logger.Info("Aguardando resultado do callback OAuth...")
+		logger.InfoContext(ctx, "Aguardando resultado do callback OAuth...")
 		select {
 		case res := <-resultChan:
-			logger.Info(fmt.Sprintf("Resultado do callback: Code=%s, Err=%v", res.Code, res.Error))
+			logger.InfoContext(ctx, "Resultado do callback", "code_received", res.Code != "", "callback_err", res.Error)
 			if res.Error != nil {
 				return authErrorMsg{err: fmt.Errorf("callback error: %w", res.Error)}
 			}
@@ -99,16 +134,38 @@ func waitForCallbackCmd(
 			}
 			return authErrorMsg{err: fmt.Errorf("nenhum código ou erro no callback")}
 		case <-ctx.Done():
-			logger.Info("Callback cancelado pelo contexto principal.")
+			logger.InfoContext(ctx, "Callback cancelado pelo contexto principal.")
 			return authErrorMsg{err: fmt.Errorf("login cancelado: %w", ctx.Err())}
 		}
 	}
 }
 
+// → Pede ao Google um código de dispositivo para o login sem navegador local
+func startDeviceLoginCmd(authService auth.AuthenticationService, ctx context.Context) tea.Cmd {
+	return func() tea.Msg {
+		device, err := authService.StartDeviceLogin(ctx)
+		if err != nil {
+			return authErrorMsg{err: err}
+		}
+		return deviceCodeMsg{device: device}
+	}
+}
+
+// → Consulta o Google até o usuário autorizar o código em outro aparelho
+func pollDeviceTokenCmd(authService auth.AuthenticationService, device *oauth2.DeviceAuthResponse, ctx context.Context) tea.Cmd {
+	return func() tea.Msg {
+		token, err := authService.WaitForDeviceToken(ctx, device)
+		if err != nil {
+			return authErrorMsg{err: err}
+		}
+		return authSuccessMsg{token: token}
+	}
+}
+
 // → Troca o código recebido por um token
-func exchangeCodeCmd(authService auth.AuthenticationService, code string, appCtx context.Context) tea.Cmd {
+func exchangeCodeCmd(authService auth.AuthenticationService, code string, attempt auth.LoginAttempt, appCtx context.Context) tea.Cmd {
 	return func() tea.Msg {
-		token, err := authService.ExchangeCodeForToken(appCtx, code)
+		token, err := authService.ExchangeCodeForToken(appCtx, code, attempt)
 		if err != nil {
 			return authErrorMsg{err: fmt.Errorf("falha na troca de token: %w", err)}
 		}
@@ -116,56 +173,155 @@ func exchangeCodeCmd(authService auth.AuthenticationService, code string, appCtx
 	}
 }
 
+// start inicia o login pelo método escolhido; browser e manual compartilham o mesmo fluxo
+// (o callback local continua ativo no manual, caso o navegador esteja na mesma máquina).
+func (m *LoginModel) start(method string) tea.Cmd {
+	m.method = method
+	m.errorMsg = ""
+	m.pasting = false
+	m.loginCtx = m.parent.operation("login")
+
+	if method == loginMethodDevice {
+		m.state = loginWaitingForDevice
+		m.statusMsg = "Solicitando código de dispositivo..."
+		m.newLoginContext()
+		return startDeviceLoginCmd(m.parent.authService, m.httpServerCtx)
+	}
+
+	m.state = loginAuthURLGenerated
+	m.statusMsg = "Gerando URL de autenticação..."
+	return startLoginCmd(
+		m.parent.authService,
+		m.parent.callbackHandler,
+		m.parent.settings.CallbackAddress,
+		"/",
+	)
+}
+
+// newLoginContext cria o subcontexto do servidor de callback ou do polling do dispositivo.
+func (m *LoginModel) newLoginContext() {
+	m.stopLogin()
+	m.httpServerCtx, m.httpServerCancel = context.WithCancel(m.loginCtx)
+}
+
+func (m *LoginModel) stopLogin() {
+	if m.httpServerCancel != nil {
+		m.httpServerCancel()
+		m.httpServerCancel = nil
+		m.parent.logger.Info("Servidor de callback finalizado.")
+	}
+}
+
+// typing indica se a caixa para colar a URL de retorno está aberta; Esc fecha só ela.
+func (m *LoginModel) typing() bool {
+	return m.state == loginWaitingForCallback && m.pasting
+}
+
+func (m *LoginModel) openPasteInput() tea.Cmd {
+	m.pasting = true
+	m.input = textinput.New()
+	m.input.Prompt = "> "
+	m.input.Placeholder = "http://127.0.0.1:…/?state=…&code=…"
+	m.input.CharLimit = 2048
+	return m.input.Focus()
+}
+
 func (m *LoginModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
-		// Se estamos no estado inicial ou em erro, Enter gera URL de login
-		if (m.state == loginIdle || m.state == loginError) && msg.Type == tea.KeyEnter {
-			m.state = loginAuthURLGenerated
-			m.statusMsg = "Gerando URL de autenticação..."
-			m.errorMsg = ""
-			return m, generateAuthURLCmd(m.parent.authService, m.csrfState)
+		// Se estamos no estado inicial ou em erro, Enter inicia o método configurado;
+		// b, m e d escolhem o navegador, a URL colada ou o código de dispositivo.
+		if m.state == loginIdle || m.state == loginError {
+			if msg.Type == tea.KeyEnter {
+				return m, m.start(m.parent.settings.LoginMethod)
+			}
+			if msg.Type == tea.KeyRunes {
+				switch string(msg.Runes) {
+				case "b":
+					return m, m.start(loginMethodBrowser)
+				case "m":
+					return m, m.start(loginMethodManual)
+				case "d":
+					return m, m.start(loginMethodDevice)
+				}
+			}
+			return m, nil
 		}
 
-	case authURLGeneratedMsg:
-		m.authURL = msg.url
-		m.statusMsg = "Abra este link no seu navegador para autenticar:\n"
-		// Tenta abrir o navegador automaticamente
-		go func() {
-			if err := browser.OpenURL(m.authURL); err != nil {
-				m.parent.logger.Error("Não foi possível abrir o navegador", err)
+		if m.state == loginWaitingForCallback {
+			if m.pasting {
+				if msg.Type == tea.KeyEnter {
+					code, err := auth.ParseManualCode(m.input.Value(), m.attempt)
+					if err != nil {
+						m.errorMsg = err.Error()
+						return m, nil
+					}
+					m.errorMsg = ""
+					m.pasting = false
+					return m, func() tea.Msg { return authSuccessMsg{code: code} }
+				}
+				if msg.Type == tea.KeyEsc {
+					// fecha só a caixa; p abre de novo
+					m.pasting = false
+					m.errorMsg = ""
+					return m, nil
+				}
+				var cmd tea.Cmd
+				m.input, cmd = m.input.Update(msg)
+				return m, cmd
 			}
-		}()
+			if msg.Type == tea.KeyRunes && string(msg.Runes) == "p" {
+				return m, m.openPasteInput()
+			}
+		}
 
+	case authURLGeneratedMsg:
+		m.attempt = msg.attempt
+		m.authURL = msg.attempt.AuthURL
 		m.state = loginWaitingForCallback
 
+		var cmds []tea.Cmd
+		if m.method == loginMethodManual {
+			m.statusMsg = "Abra este link em qualquer navegador e autorize o acesso:\n"
+			cmds = append(cmds, m.openPasteInput())
+		} else {
+			m.statusMsg = "Abra este link no seu navegador para autenticar:\n"
+			// Tenta abrir o navegador automaticamente
+			go func() {
+				if err := browser.OpenURL(m.authURL); err != nil {
+					m.parent.logger.Error("Não foi possível abrir o navegador", err)
+				}
+			}()
+		}
+
 		// Cria subcontexto para o servidor de callback
-		serverCtx, serverCancel := context.WithCancel(m.parent.appContext)
-		m.httpServerCtx = serverCtx
-		m.httpServerCancel = serverCancel
+		m.newLoginContext()
 
-		return m, waitForCallbackCmd(
+		cmds = append(cmds, waitForCallbackCmd(
 			m.httpServerCtx,
 			m.parent.callbackHandler,
-			m.csrfState,
-			":8080",
+			msg.listener,
+			m.attempt.State,
 			"/",
 			m.parent.logger,
-		)
+		))
+		return m, tea.Batch(cmds...)
+
+	case deviceCodeMsg:
+		m.device = msg.device
+		m.statusMsg = "Em qualquer aparelho com navegador, acesse o endereço abaixo e digite o código:\n"
+		return m, pollDeviceTokenCmd(m.parent.authService, msg.device, m.httpServerCtx)
 
 	case authSuccessMsg:
-		// Se houve httpServerCancel, encerra o servidor de callback
-		if m.httpServerCancel != nil {
-			m.httpServerCancel()
-			m.httpServerCancel = nil
-			m.parent.logger.Info("Servidor de callback finalizado.")
-		}
+		// Encerra o servidor de callback (ou o polling do dispositivo)
+		m.stopLogin()
 
 		// Se msg.code != "" → fase 1 (recebemos o código, trocar por token)
 		if msg.code != "" {
 			m.state = loginExchangingToken
+			m.pasting = false
 			m.statusMsg = "Código recebido! Trocando por token..."
-			return m, exchangeCodeCmd(m.parent.authService, msg.code, m.parent.appContext)
+			return m, exchangeCodeCmd(m.parent.authService, msg.code, m.attempt, m.loginCtx)
 		}
 
 		// msg.code == "" e token != nil → fase 2 (login concluído)
@@ -181,16 +337,18 @@ func (m *LoginModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 	case authErrorMsg:
-		// Em caso de erro, encerra o servidor de callback se estiver rodando
-		if m.httpServerCancel != nil {
-			m.httpServerCancel()
-			m.httpServerCancel = nil
-			m.parent.logger.Info("Servidor de callback encerrado devido a erro.")
+		// Respostas atrasadas de uma tentativa já concluída são ignoradas
+		if m.state == loginSuccess || m.state == loginExchangingToken {
+			return m, nil
 		}
+		// Em caso de erro, encerra o servidor de callback se estiver rodando
+		m.stopLogin()
 		m.state = loginError
+		m.pasting = false
+		m.device = nil
 		m.errorMsg = fmt.Sprintf("Falha no login: %v", msg.err)
 		m.statusMsg = "Pressione Enter para tentar novamente."
-		m.parent.logger.Error("authErrorMsg recebido", msg.err)
+		m.parent.logger.ErrorContext(m.loginCtx, "authErrorMsg recebido", msg.err)
 	}
 
 	return m, nil
@@ -211,10 +369,37 @@ func (m *LoginModel) View() string {
 	b.WriteString(m.statusMsg)
 	b.WriteString("\n")
 
+	if m.state == loginIdle || m.state == loginError {
+		b.WriteString("\n")
+		b.WriteString(welcomePromptStyle.Render(fmt.Sprintf("Método padrão: %s", m.parent.settings.LoginMethod)))
+		b.WriteString("\n")
+		b.WriteString(welcomePromptStyle.Render("b: navegador • m: colar URL de retorno • d: código de dispositivo (sem navegador)"))
+	}
+
 	if m.state == loginWaitingForCallback && m.authURL != "" {
 		b.WriteString(urlStyle.Render(m.authURL))
 		b.WriteString("\n\n")
-		b.WriteString(welcomePromptStyle.Render("Aguardando a autenticação no navegador..."))
+		if m.pasting {
+			b.WriteString(welcomePromptStyle.Render("Depois de autorizar, o navegador vai para um endereço http://127.0.0.1:… (a página pode não abrir)."))
+			b.WriteString("\n")
+			b.WriteString(welcomePromptStyle.Render("Copie esse endereço completo (ou só o código) e cole abaixo, depois Enter (Esc fecha a caixa):"))
+			b.WriteString("\n")
+			b.WriteString(m.input.View())
+		} else {
+			b.WriteString(welcomePromptStyle.Render("Aguardando a autenticação no navegador... (p para colar a URL de retorno manualmente)"))
+		}
+	}
+
+	if m.state == loginWaitingForDevice && m.device != nil {
+		b.WriteString(urlStyle.Render(m.device.VerificationURI))
+		b.WriteString("\n\n")
+		b.WriteString(selectedListItemStyle.Render(fmt.Sprintf("Código: %s", m.device.UserCode)))
+		b.WriteString("\n\n")
+		waiting := "Aguardando a autorização..."
+		if !m.device.Expiry.IsZero() {
+			waiting = fmt.Sprintf("Aguardando a autorização (o código expira às %s)...", m.device.Expiry.Format("15:04"))
+		}
+		b.WriteString(welcomePromptStyle.Render(waiting))
 	}
 
 	b.WriteString("\n\n")
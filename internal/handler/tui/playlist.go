@@ -12,9 +12,18 @@ import (
 type playlistsLoadedMsg struct{ playlists []domain.Playlist }
 type playlistLoadErrorMsg struct{ err error }
 
+// playlistMatch é uma playlist visível com o filtro atual e as letras a destacar no título.
+type playlistMatch struct {
+	index     int // em PlaylistsModel.playlists
+	positions []int
+}
+
 type PlaylistsModel struct {
 	parent        *AppModel
 	playlists     []domain.Playlist
+	visible       []playlistMatch
+	filter        string
+	search        searchBox
 	cursor        int
 	err           error
 	loading       bool
@@ -38,13 +47,15 @@ func (m *PlaylistsModel) Init() tea.Cmd {
 	m.statusMessage = ""
 	m.parent.logger.Info("PlaylistsModel: Init chamado, buscando playlists…")
 
+	ctx := m.parent.startJob("fetch")
 	return func() tea.Msg {
-		playlists, err := m.parent.playlistUseCase.GetMinePlaylists(m.parent.appContext)
+		playlists, err := m.parent.playlistUseCase.GetMinePlaylists(ctx)
+		err = m.parent.finishJob(ctx, err)
 		if err != nil {
-			m.parent.logger.Error("Falha ao obter playlists", err)
+			m.parent.logger.ErrorContext(ctx, "Falha ao obter playlists", err)
 			return playlistLoadErrorMsg{err: err}
 		}
-		m.parent.logger.Info(fmt.Sprintf("Solicitação concluída: %d playlists.", len(playlists)))
+		m.parent.logger.InfoContext(ctx, "Solicitação concluída", "playlists", len(playlists))
 		return playlistsLoadedMsg{playlists: playlists}
 	}
 }
@@ -55,6 +66,7 @@ func (m *PlaylistsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.loading = false
 		m.playlists = msg.playlists
 		m.cursor = 0
+		m.applyFilter(m.filter)
 		if len(m.playlists) == 0 {
 			m.err = fmt.Errorf("no playlists found")
 		} else {
@@ -69,14 +81,18 @@ func (m *PlaylistsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case tea.KeyMsg:
+		if m.search.active {
+			return m.updateSearch(msg)
+		}
+
 		// Ctrl+C ou Esc encerra
 		switch msg.Type {
 		case tea.KeyCtrlC, tea.KeyEsc:
 			return m, tea.Quit
 		}
 
-		// Se houver erro e apertar Enter → volta ao login
-		if m.err != nil && msg.Type == tea.KeyEnter {
+		// Se houver erro e apertar Enter → volta ao login (busca cancelada não é problema de login)
+		if m.err != nil && !jobStopped(m.err) && msg.Type == tea.KeyEnter {
 			return m, m.parent.send(showLoginMsg{})
 		}
 
@@ -96,51 +112,127 @@ func (m *PlaylistsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
+		// "a" abre a tela de contas e "c" a da conta ativa, mesmo sem playlists carregadas
+		if msg.Type == tea.KeyRunes && !m.loading {
+			switch string(msg.Runes) {
+			case "a":
+				return m, m.parent.send(showAccountsMsg{})
+			case "c":
+				return m, m.parent.send(showAccountInfoMsg{})
+			}
+		}
+
 		// Se estiver carregando ou não tiver playlists, nada faz
 		if m.loading || len(m.playlists) == 0 {
 			return m, nil
 		}
 
-		// Navegação normal: índice 0 = “via URL”, índices 1..N = playlists carregadas
-		switch msg.Type {
-		case tea.KeyUp:
-			if m.cursor > 0 {
-				m.cursor--
-			}
-		case tea.KeyDown:
-			if m.cursor < len(m.playlists) { // máximo = len(playlists)
-				m.cursor++
+		// "d" abre o relatório de vídeos repetidos entre playlists, "s" as smart playlists
+		// e "/" a busca pelo título
+		if msg.Type == tea.KeyRunes {
+			switch string(msg.Runes) {
+			case "d":
+				return m, m.parent.send(showCrossDuplicatesMsg{})
+			case "s":
+				return m, m.parent.send(showSmartPlaylistsMsg{})
+			case "/":
+				return m, m.search.open(m.filter)
 			}
+		}
+
+		// Navegação normal: índice 0 = “via URL”, índices 1..N = playlists visíveis com o filtro
+		switch msg.Type {
+		case tea.KeyUp, tea.KeyDown:
+			m.moveCursor(msg.Type)
 		case tea.KeyEnter:
 			if m.cursor == 0 {
 				// selecionou “Reordenar via URL”
 				return m, m.parent.send(showURLMsg{})
 			}
 			// selecionou playlist existente
-			selected := m.playlists[m.cursor-1]
-			m.parent.logger.Info(fmt.Sprintf("Playlist selecionada: %s (ID: %s)", selected.Title, selected.ID))
+			selected := m.playlists[m.visible[m.cursor-1].index]
+			m.parent.logger.Info("Playlist selecionada", "playlist_id", selected.ID, "title", selected.Title)
 			return m, m.parent.send(showReorderMsg{playlist: selected})
 		}
 	}
 	return m, nil
 }
 
+// typing indica se a busca está aberta; Esc fecha só ela.
+func (m *PlaylistsModel) typing() bool {
+	return m.search.active
+}
+
+func (m *PlaylistsModel) moveCursor(key tea.KeyType) {
+	switch {
+	case key == tea.KeyUp && m.cursor > 0:
+		m.cursor--
+	case key == tea.KeyDown && m.cursor < len(m.visible): // máximo = len(visible)
+		m.cursor++
+	}
+}
+
+// updateSearch refaz o filtro a cada tecla digitada; ↑/↓ continuam navegando nos resultados.
+func (m *PlaylistsModel) updateSearch(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.Type == tea.KeyUp || msg.Type == tea.KeyDown {
+		m.moveCursor(msg.Type)
+		return m, nil
+	}
+
+	filter, cmd := m.search.update(msg)
+	m.applyFilter(filter)
+	return m, cmd
+}
+
+// applyFilter recalcula as playlists visíveis. A playlist selecionada continua selecionada
+// se ainda aparecer; se não, o cursor vai para o primeiro resultado.
+func (m *PlaylistsModel) applyFilter(filter string) {
+	selected := -1
+	if m.cursor > 0 && m.cursor <= len(m.visible) {
+		selected = m.visible[m.cursor-1].index
+	}
+
+	m.filter = filter
+	m.visible = m.visible[:0]
+	for i, p := range m.playlists {
+		_, positions, ok := fuzzyMatchFields(filter, p.Title)
+		if !ok {
+			continue
+		}
+		m.visible = append(m.visible, playlistMatch{index: i, positions: positions[0]})
+	}
+
+	for i, match := range m.visible {
+		if match.index == selected {
+			m.cursor = i + 1
+			return
+		}
+	}
+	if filter != "" {
+		m.cursor = min(1, len(m.visible))
+	}
+}
+
 func (m *PlaylistsModel) View() string {
 	var b strings.Builder
 	b.WriteString(listHeaderStyle.Render("Your Playlists"))
+	b.WriteString("\n")
+	b.WriteString(welcomePromptStyle.Render(fmt.Sprintf("Perfil: %s (a para trocar, c para ver a conta e sair)", m.parent.profile)))
 	b.WriteString("\n\n")
 
 	if m.loading {
 		b.WriteString("Loading playlists…\n\n")
-		b.WriteString(welcomePromptStyle.Render("Pressione Ctrl+R para recarregar (cooldown 5m). Ctrl+C para sair."))
+		b.WriteString(welcomePromptStyle.Render("Esc cancela a busca. Ctrl+C para sair."))
 		return docStyle.Render(b.String())
 	}
 
 	if m.err != nil {
 		b.WriteString(errorMessageStyle.Render(fmt.Sprintf("Error: %v", m.err)))
 		b.WriteString("\n\n")
-		b.WriteString(welcomePromptStyle.Render("Pressione Enter para voltar ao login."))
-		b.WriteString("\n")
+		if !jobStopped(m.err) {
+			b.WriteString(welcomePromptStyle.Render("Pressione Enter para voltar ao login."))
+			b.WriteString("\n")
+		}
 		b.WriteString(welcomePromptStyle.Render("Pressione Ctrl+R para recarregar. Ctrl+C para sair."))
 		return docStyle.Render(b.String())
 	}
@@ -153,18 +245,35 @@ func (m *PlaylistsModel) View() string {
 	}
 	b.WriteString("\n")
 
-	// Em seguida, as playlists do usuário
-	for i, p := range m.playlists {
+	// Em seguida, as playlists do usuário que passam pelo filtro
+	for i, match := range m.visible {
+		title := highlightMatches(m.playlists[match.index].Title, match.positions)
 		if m.cursor == i+1 {
-			b.WriteString(selectedListItemStyle.Render(p.Title))
+			b.WriteString(selectedListItemStyle.Render(title))
 		} else {
-			b.WriteString(listItemStyle.Render(p.Title))
+			b.WriteString(listItemStyle.Render(title))
 		}
 		b.WriteString("\n")
 	}
+	if len(m.visible) == 0 {
+		b.WriteString(welcomePromptStyle.Render("  Nenhuma playlist com a busca atual."))
+		b.WriteString("\n")
+	}
 
 	b.WriteString("\n")
-	b.WriteString(welcomePromptStyle.Render("Use ↑/↓ ou j/k para navegar, Enter para selecionar."))
+	switch {
+	case m.search.active:
+		b.WriteString(m.search.View())
+		b.WriteString("\n")
+		b.WriteString(welcomePromptStyle.Render(fmt.Sprintf("%d de %d playlists — ↑/↓ navegam nos resultados, Enter fecha a busca mantendo o filtro, Esc volta ao filtro anterior, Backspace com a busca vazia limpa.", len(m.visible), len(m.playlists))))
+		return docStyle.Render(b.String())
+	case m.filter != "":
+		b.WriteString(welcomePromptStyle.Render(fmt.Sprintf("busca \"%s\": %d de %d playlists (/ altera, Enter com a busca vazia limpa)", m.filter, len(m.visible), len(m.playlists))))
+		b.WriteString("\n")
+	}
+	b.WriteString(welcomePromptStyle.Render("Use ↑/↓ ou j/k para navegar, Enter para selecionar, / para buscar."))
+	b.WriteString("\n")
+	b.WriteString(welcomePromptStyle.Render("Pressione d para ver vídeos repetidos entre playlists, s para smart playlists."))
 	b.WriteString("\n")
 	b.WriteString(welcomePromptStyle.Render("Pressione Ctrl+R para recarregar (cooldown 5m). Ctrl+C para sair."))
 
@@ -3,17 +3,40 @@ package tui
 import (
 	"TUI_playlist_reorder/internal/core/domain"
 	"TUI_playlist_reorder/internal/core/usecases"
-	"context"
 	"fmt"
 	"strings"
+	"text/template"
 	"time"
 
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
+// savePlaylistMsg chega quando a gravação iniciada por startSave termina.
 type savePlaylistMsg struct {
 	criteria string
 	title    string
+	inPlace  bool
+	manual   bool // gravou a ordem editada no modo manual em vez de aplicar criteria
+	err      error
+}
+
+type reorderVideosLoadedMsg struct {
+	playlist domain.Playlist
+	err      error
+}
+
+type reorderPreviewMsg struct {
+	criteria string
+	revision int // estado da sessão a partir do qual a prévia foi calculada
+	videos   []domain.Video
+	err      error
+}
+
+// reorderOption é uma entrada do menu; criteria vazio indica uma ação que não é ordenação.
+type reorderOption struct {
+	label    string
+	criteria string
 }
 
 type ReorderModel struct {
@@ -21,32 +44,83 @@ type ReorderModel struct {
 	playlist        domain.Playlist
 	playlistUseCase usecases.PlaylistUseCase
 
-	reorderOptions []string
+	reorderOptions []reorderOption
 	cursor         int
 
+	// tabela de vídeos com a prévia do critério selecionado no menu
+	videos          videoTable
+	tableFocused    bool
+	loadingVideos   bool
+	previews        map[string][]domain.Video // por critério, calculadas uma vez por estado da sessão
+	previewCriteria string                    // critério exibido na tabela ("" = ordem da sessão)
+	previewErr      error
+
+	choosingSave    bool // perguntando se grava na própria playlist ou como cópia
 	awaitingTitle   bool
 	pendingCriteria string
+	pendingManual   bool
 	newTitle        string
 
+	// sessão de edição: a ordem em memória, que só vai para o YouTube ao salvar. Ordenações
+	// pelo menu, movimentos e remoções do modo manual entram todos no mesmo histórico, que
+	// dura enquanto a tela estiver aberta: u/r desfazem/refazem e h lista os estados
+	edited        domain.Playlist
+	lastSaved     []domain.Video // última ordem gravada, na própria playlist ou numa cópia
+	history       *domain.EditHistory
+	revision      int // muda a cada alteração de edited, para descartar prévias atrasadas
+	showHistory   bool
+	historyCursor int
+
+	confirmingDiscard bool    // sair da tela descartaria a edição; espera a confirmação
+	discardCmd        tea.Cmd // para onde ir se o descarte for confirmado
+
+	// modo manual: edição da ordem diretamente na tabela (ver reorder_manual.go)
+	manual    bool
+	marks     map[int]bool
+	clipboard []domain.Video
+	jumping   bool
+	jumpInput textinput.Model
+
+	search searchBox // busca fuzzy por título e artista na tabela, aberta com /
+
 	awaitingSave bool
+	saveUpdates  <-chan tea.Msg // progresso e fim da gravação em andamento
+	saveProgress saveProgress
 
 	statusMessage string
 	err           error
 }
 
 func NewReorderModel(parent *AppModel, playlist domain.Playlist) *ReorderModel {
+	// Critérios embutidos e plugins de ordenação vêm do use case
+	var options []reorderOption
+	for _, sorter := range parent.playlistUseCase.ListSorters() {
+		options = append(options, reorderOption{label: sorter.Label, criteria: sorter.Name})
+	}
+	options = append(options,
+		reorderOption{label: "Remover Duplicados"},
+		reorderOption{label: "Comparar com outra playlist ou snapshot"},
+		reorderOption{label: "Copiar para outro perfil"},
+		reorderOption{label: "Voltar para Playlists"},
+	)
+
+	// Começa com o critério padrão da configuração selecionado
+	cursor := 0
+	for i, option := range options {
+		if option.criteria != "" && option.criteria == parent.settings.DefaultSort {
+			cursor = i
+			break
+		}
+	}
+
 	return &ReorderModel{
 		parent:          parent,
 		playlist:        playlist,
 		playlistUseCase: parent.playlistUseCase,
-		reorderOptions: []string{
-			"Ordenar por Nome (A-Z)",
-			"Ordenar por Duração (Menor-Maior)",
-			"Ordenar por Idioma (A-Z)",
-			"Ordenar por Data de Publicação (Mais Antigo-Mais Novo)",
-			"Voltar para Playlists",
-		},
-		cursor:          0,
+		reorderOptions:  options,
+		cursor:          cursor,
+		videos:          newVideoTable(),
+		previews:        make(map[string][]domain.Video),
 		awaitingTitle:   false,
 		pendingCriteria: "",
 		newTitle:        "",
@@ -60,16 +134,236 @@ func (m *ReorderModel) Init() tea.Cmd {
 	m.statusMessage = ""
 	m.err = nil
 	m.awaitingSave = false
-	m.parent.logger.Info(fmt.Sprintf("ReorderModel: inicializado para playlist '%s'", m.playlist.Title))
+	m.parent.logger.Info("ReorderModel: inicializado", "playlist_id", m.playlist.ID, "title", m.playlist.Title)
+	m.resizeTable()
+
+	// A lista de playlists vem sem os vídeos; a playlist aberta por URL já vem completa
+	if m.playlist.ID != "" && len(m.playlist.Videos) == 0 {
+		m.loadingVideos = true
+		playlistID := m.playlist.ID
+		ctx := m.parent.startJob("fetch-videos")
+		return func() tea.Msg {
+			playlist, err := m.playlistUseCase.GetPlaylistByID(ctx, playlistID)
+			return reorderVideosLoadedMsg{playlist: playlist, err: m.parent.finishJob(ctx, err)}
+		}
+	}
+
+	m.startSession()
+	return m.previewSelected()
+}
+
+// startSession começa a sessão de edição a partir da ordem atual da playlist.
+func (m *ReorderModel) startSession() {
+	m.edited = m.playlist
+	m.edited.Videos = append([]domain.Video(nil), m.playlist.Videos...)
+	m.lastSaved = m.playlist.Videos
+	m.history = domain.NewEditHistory("Ordem atual", m.playlist)
+	m.sessionChanged()
+}
+
+// sessionChanged invalida as prévias, calculadas a partir do estado anterior da sessão.
+func (m *ReorderModel) sessionChanged() {
+	m.revision++
+	m.previews = make(map[string][]domain.Video)
+}
+
+// previewSelected mostra na tabela a ordem que o critério selecionado no menu produziria a
+// partir do estado atual da sessão. Ações que não são ordenação mostram esse estado.
+func (m *ReorderModel) previewSelected() tea.Cmd {
+	criteria := m.reorderOptions[m.cursor].criteria
+	m.previewErr = nil
+
+	if criteria == "" {
+		m.previewCriteria = ""
+		m.videos.setVideos(m.edited.Videos)
+		return nil
+	}
+	if videos, ok := m.previews[criteria]; ok {
+		m.previewCriteria = criteria
+		m.videos.setVideos(videos)
+		return nil
+	}
+
+	playlist := m.edited
+	playlist.Videos = append([]domain.Video(nil), m.edited.Videos...)
+	revision := m.revision
+	ctx := m.parent.operation("preview")
+	return func() tea.Msg {
+		sorted, err := m.playlistUseCase.SortPlaylist(ctx, playlist, criteria)
+		return reorderPreviewMsg{criteria: criteria, revision: revision, videos: sorted.Videos, err: err}
+	}
+}
+
+// applyCriterion registra no histórico a ordenação pelo critério selecionado e pergunta onde
+// salvar. Sem outras edições na sessão, a gravação reordena pelo critério a partir da ordem
+// do YouTube; com elas, grava a ordem da sessão.
+func (m *ReorderModel) applyCriterion(option reorderOption) tea.Cmd {
+	if len(m.clipboard) > 0 {
+		// estado do histórico com vídeos recortados: salvar os tiraria da playlist
+		m.statusMessage = fmt.Sprintf("Há %d vídeo(s) recortado(s) neste estado: m abre o modo manual para colá-los, u/r mudam de estado.", len(m.clipboard))
+		return nil
+	}
+	sorted, ok := m.previews[option.criteria]
+	if !ok {
+		m.statusMessage = "Aguarde a prévia do critério antes de aplicá-lo."
+		return nil
+	}
+
+	fromYouTube := sameVideoOrder(m.edited.Videos, m.playlist.Videos)
+	m.edited.Videos = append([]domain.Video(nil), sorted...)
+	m.record("Ordenar: " + option.label)
+
+	m.pendingCriteria = option.criteria
+	m.pendingManual = !fromYouTube
+	m.choosingSave = true
+	m.statusMessage = "Ordenação registrada no histórico da sessão (u desfaz)."
+	m.err = nil
+	return m.previewSelected()
+}
+
+// leave sai da tela pelo comando informado, pedindo confirmação antes se a sessão tiver
+// edições que ainda não foram para o YouTube.
+func (m *ReorderModel) leave(cmd tea.Cmd) tea.Cmd {
+	if !m.unsaved() {
+		return cmd
+	}
+	m.confirmingDiscard = true
+	m.discardCmd = cmd
+	m.statusMessage = fmt.Sprintf("Descartar a edição não salva e o histórico (%d estados)? y ou Backspace confirmam, outra tecla mantém.",
+		len(m.history.Entries()))
 	return nil
 }
 
+// unsaved indica se a ordem da sessão ainda não foi gravada em lugar nenhum.
+func (m *ReorderModel) unsaved() bool {
+	if m.history == nil {
+		return false
+	}
+	return len(m.clipboard) > 0 || !sameVideoOrder(m.edited.Videos, m.lastSaved)
+}
+
+// typing indica se a tela está com uma caixa de texto ou pergunta aberta, em que Esc
+// fecha só ela.
+func (m *ReorderModel) typing() bool {
+	return m.search.active || m.jumping || m.awaitingTitle || m.choosingSave || m.confirmingDiscard
+}
+
+// removedItemIDs lista os itens da playlist no YouTube que a sessão tirou; só eles são
+// removidos numa gravação na própria playlist.
+func (m *ReorderModel) removedItemIDs() []string {
+	kept := make(map[string]bool, len(m.edited.Videos))
+	for _, video := range m.edited.Videos {
+		kept[video.ItemID] = true
+	}
+	var removed []string
+	for _, video := range m.playlist.Videos {
+		if video.ItemID != "" && !kept[video.ItemID] {
+			removed = append(removed, video.ItemID)
+		}
+	}
+	return removed
+}
+
+// criterionLabel é o nome do critério no menu.
+func (m *ReorderModel) criterionLabel(criteria string) string {
+	for _, option := range m.reorderOptions {
+		if option.criteria == criteria {
+			return option.label
+		}
+	}
+	return criteria
+}
+
+func sameVideoOrder(a, b []domain.Video) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].ID != b[i].ID {
+			return false
+		}
+	}
+	return true
+}
+
+// resizeTable reparte a tela entre a tabela e o menu de opções.
+func (m *ReorderModel) resizeTable() {
+	height := 0
+	if m.parent.height > 0 {
+		// título, cabeçalho da tabela, menu, status e ajuda
+		height = max(m.parent.height-len(m.reorderOptions)-16, 3)
+	}
+	width := 0
+	if m.parent.width > 0 {
+		width = m.parent.width - 4 // margens do docStyle
+	}
+	m.videos.resize(width, height)
+}
+
 func (m *ReorderModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 
+	case tea.WindowSizeMsg:
+		m.resizeTable()
+		return m, nil
+
+	case reorderVideosLoadedMsg:
+		m.loadingVideos = false
+		if msg.err != nil {
+			m.err = msg.err
+			m.statusMessage = "Não foi possível carregar os vídeos da playlist."
+			return m, nil
+		}
+		title := m.playlist.Title
+		m.playlist = msg.playlist
+		if m.playlist.Title == "" {
+			m.playlist.Title = title
+		}
+		m.startSession()
+		return m, m.previewSelected()
+
+	case reorderPreviewMsg:
+		if msg.revision != m.revision {
+			// calculada para um estado da sessão que já mudou
+			return m, nil
+		}
+		if msg.err != nil {
+			if msg.criteria == m.reorderOptions[m.cursor].criteria {
+				m.previewErr = msg.err
+			}
+			return m, nil
+		}
+		m.previews[msg.criteria] = msg.videos
+		// o cursor pode ter andado enquanto a prévia era calculada; no modo manual a tabela
+		// mostra a ordem editada
+		if msg.criteria == m.reorderOptions[m.cursor].criteria && !m.manual {
+			m.previewCriteria = msg.criteria
+			m.videos.setVideos(msg.videos)
+		}
+		return m, nil
+
 	case tea.KeyMsg:
 		if m.awaitingSave {
-			// Enquanto o timer estiver rodando, não aceitamos input
+			// Enquanto a gravação estiver rodando, não aceitamos input
+			return m, nil
+		}
+
+		if m.choosingSave {
+			switch {
+			case msg.Type == tea.KeyRunes && string(msg.Runes) == "i":
+				m.choosingSave = false
+				return m, m.startSave(true, "")
+			case msg.Type == tea.KeyRunes && string(msg.Runes) == "c":
+				m.choosingSave = false
+				m.awaitingTitle = true
+				criteria := m.pendingCriteria
+				if m.pendingManual && criteria == "" {
+					criteria = "manual"
+				}
+				m.newTitle = m.suggestedTitle(criteria)
+			case msg.Type == tea.KeyBackspace, msg.Type == tea.KeyEsc:
+				m.choosingSave = false
+			}
 			return m, nil
 		}
 
@@ -87,27 +381,13 @@ func (m *ReorderModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					return m, nil
 				}
 
-				// Atualiza localmente antes de aguardar o timer
-				switch m.pendingCriteria {
-				case "name":
-					m.playlist.SortByName()
-				case "duration":
-					m.playlist.SortByDuration()
-				case "language":
-					m.playlist.SortByLanguage()
-				case "publish":
-					m.playlist.SortByPublish()
-				}
+				return m, m.startSave(false, title)
 
-				// Entra no modo “loading” de 10s
-				m.awaitingSave = true
+			case tea.KeyEsc:
+				m.awaitingTitle = false
 				m.err = nil
-				m.statusMessage = fmt.Sprintf("Salvando playlist \"%s\" no YouTube. Aguarde...", title)
-
-				// Após 10 segundos, dispara um TeaMsg que manda executar o salvamento real
-				return m, tea.Tick(time.Second*10, func(t time.Time) tea.Msg {
-					return savePlaylistMsg{criteria: m.pendingCriteria, title: title}
-				})
+				m.statusMessage = "Cópia cancelada; nada foi salvo."
+				return m, nil
 
 			case tea.KeyBackspace:
 				if len(m.newTitle) > 0 {
@@ -123,76 +403,205 @@ func (m *ReorderModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 
+		if m.confirmingDiscard {
+			m.confirmingDiscard = false
+			cmd := m.discardCmd
+			m.discardCmd = nil
+			if key := msg.String(); key == "y" || key == "backspace" {
+				return m, cmd
+			}
+			m.statusMessage = "Edição mantida."
+			return m, nil
+		}
+		if m.showHistory {
+			return m.updateHistory(msg)
+		}
+
+		if m.manual {
+			return m.updateManual(msg)
+		}
+
+		if m.search.active {
+			return m.updateSearch(msg)
+		}
+		if msg.Type == tea.KeyRunes && string(msg.Runes) == "/" && !m.loadingVideos {
+			return m, m.search.open(m.videos.filter)
+		}
+
+		if msg.Type == tea.KeyRunes && string(msg.Runes) == "m" && !m.loadingVideos {
+			m.enterManual()
+			return m, nil
+		}
+
+		// histórico da sessão, também fora do modo manual
+		if m.history != nil && !m.loadingVideos {
+			switch msg.String() {
+			case "u", "ctrl+z":
+				return m, m.undo()
+			case "r", "ctrl+y":
+				return m, m.redo()
+			case "h":
+				m.showHistory = true
+				m.historyCursor = m.history.Current()
+				return m, nil
+			}
+		}
+
+		// Teclas da tabela: Tab alterna o foco entre menu e tabela, 1–6 ordenam por coluna
+		// e 0 volta para a ordem do critério
+		switch {
+		case msg.Type == tea.KeyTab:
+			m.tableFocused = !m.tableFocused
+			if m.tableFocused {
+				m.videos.table.Focus()
+			} else {
+				m.videos.table.Blur()
+			}
+			return m, nil
+		case msg.Type == tea.KeyRunes && len(msg.Runes) == 1 && msg.Runes[0] >= '0' && msg.Runes[0] <= '6':
+			m.videos.sortBy(int(msg.Runes[0]-'0') - 1)
+			return m, nil
+		case m.tableFocused && msg.Type != tea.KeyBackspace:
+			var cmd tea.Cmd
+			m.videos.table, cmd = m.videos.table.Update(msg)
+			return m, cmd
+		}
+
 		// Modo normal (navegar opções)
 		switch msg.Type {
 		case tea.KeyUp:
 			if m.cursor > 0 {
 				m.cursor--
+				return m, m.previewSelected()
 			}
 		case tea.KeyDown:
 			if m.cursor < len(m.reorderOptions)-1 {
 				m.cursor++
+				return m, m.previewSelected()
 			}
 		case tea.KeyEnter:
 			selecionado := m.reorderOptions[m.cursor]
-			switch selecionado {
-			case "Ordenar por Nome (A-Z)":
-				m.pendingCriteria = "name"
-				m.awaitingTitle = true
-				m.newTitle = ""
-				m.statusMessage = ""
-				m.err = nil
+			if selecionado.criteria != "" {
+				return m, m.applyCriterion(selecionado)
+			}
 
-			case "Ordenar por Duração (Menor-Maior)":
-				m.pendingCriteria = "duration"
-				m.awaitingTitle = true
-				m.newTitle = ""
-				m.statusMessage = ""
-				m.err = nil
+			// as outras ações abrem outra tela e trabalham com a playlist do YouTube
+			switch selecionado.label {
+			case "Remover Duplicados":
+				return m, m.leave(m.parent.send(showDedupeMsg{playlist: m.playlist}))
 
-			case "Ordenar por Idioma (A-Z)":
-				m.pendingCriteria = "language"
-				m.awaitingTitle = true
-				m.newTitle = ""
-				m.statusMessage = ""
-				m.err = nil
+			case "Comparar com outra playlist ou snapshot":
+				return m, m.leave(m.parent.send(showDiffMsg{playlist: m.playlist}))
 
-			case "Ordenar por Data de Publicação (Mais Antigo-Mais Novo)":
-				m.pendingCriteria = "publish"
-				m.awaitingTitle = true
-				m.newTitle = ""
-				m.statusMessage = ""
-				m.err = nil
+			case "Copiar para outro perfil":
+				playlist := m.playlist
+				return m, m.leave(m.parent.send(showAccountsMsg{copySource: &playlist}))
 
 			case "Voltar para Playlists":
-				return m, m.parent.send(showPlaylistsMsg{})
+				return m, m.leave(m.parent.send(showPlaylistsMsg{}))
 			}
 		case tea.KeyBackspace:
-			return m, m.parent.send(showPlaylistsMsg{})
+			return m, m.leave(m.parent.send(showPlaylistsMsg{}))
 		}
 	}
 
-	// Mensagem customizada para, após o timer, efetivar o salvamento
+	// Progresso e fim da gravação, que roda fora do Update (ver reorder_save.go)
 	switch msg := msg.(type) {
+	case saveProgressMsg:
+		m.saveProgress.update(msg.event)
+		return m, waitSaveUpdate(m.saveUpdates)
+
 	case savePlaylistMsg:
 		m.awaitingSave = false
+		m.saveUpdates = nil
 
-		// Executa o use case de fato
-		err := m.playlistUseCase.ReorderPlaylist(context.Background(), m.playlist.ID, msg.criteria, msg.title)
-		if err != nil {
+		if err := msg.err; err != nil {
 			m.err = err
 			m.statusMessage = fmt.Sprintf("Erro ao salvar no YouTube: %v", err)
-		} else {
-			m.err = nil
-			m.statusMessage = "Playlist salva com sucesso no YouTube."
+			if jobStopped(err) {
+				m.statusMessage = stoppedSaveMessage(msg.inPlace, m.saveProgress.last)
+			}
+			return m, nil
+		}
+
+		m.err = nil
+		m.statusMessage = "Playlist salva com sucesso no YouTube."
+		m.lastSaved = append([]domain.Video(nil), m.edited.Videos...)
+		if !msg.inPlace {
 			m.playlist.Title = msg.title
+			return m, nil
 		}
-		return m, nil
+
+		// a playlist mudou no YouTube: a ordem da sessão, que foi a gravada, vira a ordem
+		// atual; o histórico continua, e desfazer volta a deixar a sessão diferente do YouTube
+		m.playlist.Videos = append([]domain.Video(nil), m.edited.Videos...)
+		if m.manual {
+			return m, m.leaveManual()
+		}
+		return m, m.previewSelected()
 	}
 
 	return m, nil
 }
 
+// updateSearch filtra a tabela a cada tecla digitada na busca; ↑/↓ continuam movendo o
+// cursor da tabela enquanto se digita.
+func (m *ReorderModel) updateSearch(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyUp:
+		m.videos.table.MoveUp(1)
+		return m, nil
+	case tea.KeyDown:
+		m.videos.table.MoveDown(1)
+		return m, nil
+	}
+
+	filter, cmd := m.search.update(msg)
+	m.videos.setFilter(filter)
+	return m, cmd
+}
+
+// searchView mostra a caixa de busca aberta ou o filtro aplicado à tabela.
+func (m *ReorderModel) searchView() string {
+	switch {
+	case m.search.active:
+		return m.search.View() + "\n" + welcomePromptStyle.Render(fmt.Sprintf("%d de %d vídeos — Enter fecha a busca mantendo o filtro, Esc volta ao filtro anterior, Backspace com a busca vazia limpa.", len(m.videos.positions), len(m.videos.videos))) + "\n"
+	case m.videos.filter != "":
+		return welcomePromptStyle.Render(fmt.Sprintf("busca \"%s\": %d de %d vídeos (/ altera, Enter com a busca vazia limpa)", m.videos.filter, len(m.videos.positions), len(m.videos.videos))) + "\n"
+	}
+	return ""
+}
+
+// suggestedTitle preenche o campo de título a partir do title_template da configuração.
+func (m *ReorderModel) suggestedTitle(criteria string) string {
+	if m.parent.settings.TitleTemplate == "" {
+		return ""
+	}
+
+	tmpl, err := template.New("title").Parse(m.parent.settings.TitleTemplate)
+	if err != nil {
+		m.parent.logger.Error("ReorderModel: title_template inválido", err)
+		return ""
+	}
+
+	var b strings.Builder
+	data := struct {
+		Title    string
+		Criteria string
+		Date     string
+	}{
+		Title:    m.playlist.Title,
+		Criteria: criteria,
+		Date:     time.Now().Format(time.DateOnly),
+	}
+	if err := tmpl.Execute(&b, data); err != nil {
+		m.parent.logger.Error("ReorderModel: falha ao aplicar title_template", err)
+		return ""
+	}
+
+	return b.String()
+}
+
 func (m *ReorderModel) View() string {
 	var b strings.Builder
 
@@ -200,11 +609,18 @@ func (m *ReorderModel) View() string {
 	b.WriteString(title)
 	b.WriteString("\n\n")
 
-	// Se estivermos aguardando o timer de “loading”
+	// Gravação em andamento
 	if m.awaitingSave {
 		b.WriteString("⏳ ")
 		b.WriteString(statusMessageStyle.Render(m.statusMessage))
 		b.WriteString("\n\n")
+		b.WriteString(m.saveProgress.View(m.parent.width))
+		b.WriteString("\n")
+		if m.parent.writingJobs() {
+			b.WriteString(welcomePromptStyle.Render("Esc interrompe a gravação depois do item em andamento."))
+		} else {
+			b.WriteString(welcomePromptStyle.Render("Esc cancela a gravação."))
+		}
 		return docStyle.Render(b.String())
 	}
 
@@ -221,15 +637,39 @@ func (m *ReorderModel) View() string {
 		return docStyle.Render(b.String())
 	}
 
+	if m.choosingSave {
+		b.WriteString("Onde salvar a nova ordem?\n")
+		if removed := len(m.playlist.Videos) - len(m.edited.Videos); removed > 0 {
+			b.WriteString(statusMessageStyle.Render(fmt.Sprintf("%d vídeo(s) removido(s) na edição sairão da playlist.", removed)))
+			b.WriteString("\n")
+		}
+		b.WriteString(listItemStyle.Render("i — na própria playlist (só os itens fora do lugar são movidos; um snapshot da ordem atual é salvo antes)"))
+		b.WriteString("\n")
+		b.WriteString(listItemStyle.Render("c — em uma cópia, com um novo título"))
+		b.WriteString("\n\n")
+		b.WriteString(welcomePromptStyle.Render("Backspace ou Esc cancela."))
+		return docStyle.Render(b.String())
+	}
+
 	// Caso normal: exibe vídeos e opções
-	b.WriteString("Playlist atual:" + m.playlist.Title + "\n")
+	b.WriteString(m.videosView())
+	b.WriteString("\n")
+
+	if m.manual {
+		b.WriteString(m.manualHelpView())
+		return docStyle.Render(b.String())
+	}
+	if m.showHistory {
+		b.WriteString(m.historyView())
+		return docStyle.Render(b.String())
+	}
 
 	b.WriteString("Opções de Reordenação:\n")
 	for i, opt := range m.reorderOptions {
 		if m.cursor == i {
-			b.WriteString(selectedListItemStyle.Render(opt))
+			b.WriteString(selectedListItemStyle.Render(opt.label))
 		} else {
-			b.WriteString(listItemStyle.Render(opt))
+			b.WriteString(listItemStyle.Render(opt.label))
 		}
 		b.WriteString("\n")
 	}
@@ -245,7 +685,56 @@ func (m *ReorderModel) View() string {
 	}
 
 	b.WriteString("\n")
-	b.WriteString(welcomePromptStyle.Render("Use ↑/↓ para navegar, Enter para selecionar, Backspace para voltar. Ctrl+C para sair."))
+	if m.tableFocused {
+		b.WriteString(welcomePromptStyle.Render("Tabela: ↑/↓ e PgUp/PgDn rolam, 1–6 ordenam pela coluna (de novo inverte), 0 volta à ordem do critério, / busca, Tab volta ao menu."))
+	} else {
+		b.WriteString(welcomePromptStyle.Render("Use ↑/↓ para navegar (a tabela mostra a prévia), Enter para selecionar, Tab para rolar a tabela, 1–6 ordenam por coluna, / busca vídeos, m para reordenar manualmente, u/r desfazem/refazem, h mostra o histórico, Backspace para voltar. Ctrl+C para sair."))
+	}
 
 	return docStyle.Render(b.String())
 }
+
+func (m *ReorderModel) videosView() string {
+	var b strings.Builder
+
+	switch {
+	case m.loadingVideos:
+		b.WriteString("⏳ Carregando vídeos da playlist… (Esc cancela)\n")
+		return b.String()
+	case len(m.playlist.Videos) == 0:
+		b.WriteString(welcomePromptStyle.Render("Playlist sem vídeos."))
+		b.WriteString("\n")
+		return b.String()
+	}
+
+	if m.manual {
+		b.WriteString(welcomePromptStyle.Render(fmt.Sprintf("%d vídeos — ordem manual", len(m.edited.Videos))))
+		b.WriteString("\n")
+		b.WriteString(m.searchView())
+		b.WriteString(m.videos.table.View())
+		b.WriteString("\n")
+		return b.String()
+	}
+
+	order := "ordem atual"
+	if m.unsaved() {
+		order = "ordem editada, ainda não salva"
+	}
+	if m.previewCriteria != "" {
+		order = "prévia: " + m.reorderOptions[m.cursor].label
+	}
+	if m.previewCriteria != m.reorderOptions[m.cursor].criteria && m.previewErr == nil {
+		order += " (calculando…)"
+	}
+	b.WriteString(welcomePromptStyle.Render(fmt.Sprintf("%d vídeos — %s", len(m.edited.Videos), order)))
+	b.WriteString("\n")
+	if m.previewErr != nil {
+		b.WriteString(errorMessageStyle.Render(fmt.Sprintf("Prévia indisponível: %v", m.previewErr)))
+		b.WriteString("\n")
+	}
+
+	b.WriteString(m.searchView())
+	b.WriteString(m.videos.table.View())
+	b.WriteString("\n")
+	return b.String()
+}
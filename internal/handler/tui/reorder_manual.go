@@ -0,0 +1,418 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"TUI_playlist_reorder/internal/core/domain"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// enterManual continua a sessão de edição no modo manual a partir da ordem mostrada na
+// tabela; se ela for a prévia de um critério, a ordenação entra no histórico. Nada vai para o
+// YouTube até o usuário salvar.
+func (m *ReorderModel) enterManual() {
+	if m.history == nil {
+		m.startSession()
+	}
+	// só vale a prévia já calculada para o estado atual da sessão
+	if videos, ok := m.previews[m.previewCriteria]; ok && m.previewCriteria != "" {
+		m.edited.Videos = append([]domain.Video(nil), videos...)
+		m.record("Ordenar: " + m.criterionLabel(m.previewCriteria))
+	}
+	m.marks = make(map[int]bool)
+	m.clipboard = m.history.Held()
+	m.manual = true
+	m.statusMessage = ""
+	m.err = nil
+
+	// no modo manual a tabela mostra sempre a ordem editada, com o cursor nela
+	m.tableFocused = true
+	m.videos.table.Focus()
+	m.videos.sortBy(-1)
+	m.videos.marks = m.marks
+	m.videos.setVideos(m.edited.Videos)
+}
+
+// leaveManual volta ao menu mantendo a ordem editada e o histórico da sessão; a tabela volta
+// a mostrar a prévia do critério selecionado a partir dessa ordem.
+func (m *ReorderModel) leaveManual() tea.Cmd {
+	m.manual = false
+	m.jumping = false
+	m.marks = nil
+	m.videos.marks = nil
+	m.tableFocused = false
+	m.videos.table.Blur()
+	return m.previewSelected()
+}
+
+func (m *ReorderModel) updateManual(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.jumping {
+		return m.updateJump(msg)
+	}
+	if m.search.active {
+		return m.updateSearch(msg)
+	}
+
+	// posição, na ordem editada, do vídeo sob o cursor (a busca pode esconder linhas)
+	cursor, onRow := m.videos.selectedPosition()
+
+	switch msg.String() {
+	case "backspace":
+		// fora do modo manual a ordem editada não teria onde colar o que foi recortado
+		if len(m.clipboard) > 0 {
+			m.statusMessage = fmt.Sprintf("Cole (p/P) os %d vídeo(s) recortado(s) ou desfaça o recorte (u) antes de sair do modo manual.", len(m.clipboard))
+			return m, nil
+		}
+		if m.unsaved() {
+			m.statusMessage = "Edição mantida na sessão: m volta ao modo manual, u/r desfazem/refazem."
+		}
+		return m, m.leaveManual()
+
+	case "shift+up", "K":
+		description := m.describeTargets("Subir")
+		m.moveSelection(m.edited.MoveBy(m.targets(), -1))
+		m.record(description)
+	case "shift+down", "J":
+		description := m.describeTargets("Descer")
+		m.moveSelection(m.edited.MoveBy(m.targets(), 1))
+		m.record(description)
+	case "t":
+		description := m.describeTargets("Levar ao topo")
+		m.moveSelection(m.edited.MoveTo(m.targets(), 0))
+		m.record(description)
+	case "b":
+		description := m.describeTargets("Levar ao fim")
+		targets := m.targets()
+		m.moveSelection(m.edited.MoveTo(targets, len(m.edited.Videos)-len(targets)))
+		m.record(description)
+
+	case "d", "delete":
+		targets := m.targets()
+		if len(targets) == 0 {
+			return m, nil
+		}
+		description := m.describeTargets("Remover")
+		m.edited.RemoveAt(targets)
+		m.record(description)
+		clear(m.marks)
+		m.videos.setVideos(m.edited.Videos)
+		m.videos.selectPosition(min(targets[0], max(len(m.edited.Videos)-1, 0)))
+		m.statusMessage = fmt.Sprintf("%d vídeo(s) removido(s) da ordem editada; saem da playlist ao salvar (u desfaz).", len(targets))
+	case "D":
+		// remove as duplicatas exatas (mesmo vídeo), mantendo a primeira ocorrência
+		var exact []domain.DuplicateGroup
+		for _, group := range m.edited.FindDuplicates() {
+			if group.Kind == domain.DuplicateExact {
+				exact = append(exact, group)
+			}
+		}
+		positions := domain.DuplicatePositions(exact)
+		if len(positions) == 0 {
+			m.statusMessage = "Nenhuma duplicata exata na ordem editada."
+			return m, nil
+		}
+		m.edited.RemoveAt(positions)
+		m.record(fmt.Sprintf("Remover duplicatas: %d vídeos", len(positions)))
+		clear(m.marks)
+		m.videos.setVideos(m.edited.Videos)
+		m.statusMessage = fmt.Sprintf("%d duplicata(s) exata(s) removida(s) da ordem editada (u desfaz).", len(positions))
+
+	case "u", "ctrl+z":
+		return m, m.undo()
+	case "r", "ctrl+y":
+		return m, m.redo()
+	case "h":
+		m.showHistory = true
+		m.historyCursor = m.history.Current()
+	case "/":
+		return m, m.search.open(m.videos.filter)
+
+	case "n":
+		m.jumping = true
+		m.jumpInput = textinput.New()
+		m.jumpInput.Prompt = "Mover para a posição: "
+		m.jumpInput.CharLimit = 6
+		m.jumpInput.Width = 10
+		return m, m.jumpInput.Focus()
+
+	case " ":
+		if !onRow {
+			return m, nil
+		}
+		if m.marks[cursor] {
+			delete(m.marks, cursor)
+		} else {
+			m.marks[cursor] = true
+		}
+		m.videos.refresh()
+
+	case "x":
+		targets := m.targets()
+		if len(targets) == 0 {
+			return m, nil
+		}
+		description := m.describeTargets("Recortar")
+		// um novo recorte junta-se ao anterior (ainda não colado) em vez de substituí-lo
+		m.clipboard = append(m.clipboard, m.edited.RemoveAt(targets)...)
+		m.record(description)
+		clear(m.marks)
+		m.videos.setVideos(m.edited.Videos)
+		m.videos.selectPosition(min(targets[0], max(len(m.edited.Videos)-1, 0)))
+		m.statusMessage = fmt.Sprintf("%d vídeo(s) recortado(s); p cola depois do cursor, P antes.", len(m.clipboard))
+
+	case "p", "P":
+		if len(m.clipboard) == 0 {
+			m.statusMessage = "Nada recortado para colar."
+			return m, nil
+		}
+		index := cursor
+		switch {
+		case !onRow:
+			index = len(m.edited.Videos)
+		case msg.String() == "p":
+			index = cursor + 1
+		}
+		pasted := m.clipboard
+		m.clipboard = nil
+		m.edited.InsertAt(index, pasted)
+		positions := make([]int, len(pasted))
+		for i := range pasted {
+			positions[i] = min(index, len(m.edited.Videos)-len(pasted)) + i
+		}
+		m.moveSelection(positions)
+		m.record(fmt.Sprintf("Colar %d vídeo(s) na posição %d", len(pasted), positions[0]+1))
+		m.statusMessage = ""
+
+	case "s":
+		// salvar com vídeos recortados os removeria da playlist no YouTube; só saem os
+		// removidos de propósito (d, D)
+		if len(m.clipboard) > 0 {
+			m.statusMessage = fmt.Sprintf("Cole (p/P) os %d vídeo(s) recortado(s) ou desfaça o recorte (u) antes de salvar.", len(m.clipboard))
+			return m, nil
+		}
+		m.pendingCriteria = ""
+		m.pendingManual = true
+		m.choosingSave = true
+		m.statusMessage = ""
+		m.err = nil
+
+	default:
+		var cmd tea.Cmd
+		m.videos.table, cmd = m.videos.table.Update(msg)
+		return m, cmd
+	}
+
+	return m, nil
+}
+
+func (m *ReorderModel) updateJump(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEnter:
+		m.jumping = false
+		position, err := strconv.Atoi(strings.TrimSpace(m.jumpInput.Value()))
+		if err != nil || position < 1 || position > len(m.edited.Videos) {
+			m.statusMessage = fmt.Sprintf("Posição inválida: use um número de 1 a %d.", len(m.edited.Videos))
+			return m, nil
+		}
+		description := m.describeTargets(fmt.Sprintf("Mover para a posição %d", position))
+		targets := m.targets()
+		// o bloco movido não pode passar do fim da playlist
+		target := min(position-1, len(m.edited.Videos)-len(targets))
+		m.moveSelection(m.edited.MoveTo(targets, target))
+		m.record(description)
+		return m, nil
+	case tea.KeyEsc, tea.KeyBackspace:
+		if msg.Type == tea.KeyEsc || m.jumpInput.Value() == "" {
+			m.jumping = false
+			return m, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	m.jumpInput, cmd = m.jumpInput.Update(msg)
+	return m, cmd
+}
+
+// targets são as posições marcadas com espaço ou, sem marcas, a do cursor.
+func (m *ReorderModel) targets() []int {
+	if len(m.marks) == 0 {
+		if cursor, ok := m.videos.selectedPosition(); ok {
+			return []int{cursor}
+		}
+		return nil
+	}
+
+	positions := make([]int, 0, len(m.marks))
+	for pos := range m.marks {
+		positions = append(positions, pos)
+	}
+	sort.Ints(positions)
+	return positions
+}
+
+// moveSelection atualiza a tabela depois de uma edição: as marcas acompanham os vídeos
+// movidos e o cursor vai para o primeiro deles.
+func (m *ReorderModel) moveSelection(positions []int) {
+	if len(positions) == 0 {
+		return
+	}
+	if len(m.marks) > 0 {
+		clear(m.marks)
+		for _, pos := range positions {
+			m.marks[pos] = true
+		}
+	}
+	m.videos.setVideos(m.edited.Videos)
+	m.videos.selectPosition(positions[0])
+}
+
+// record registra no histórico o estado da sessão depois de uma edição, com o que estiver
+// recortado.
+func (m *ReorderModel) record(description string) {
+	if m.history.Record(description, m.edited, m.clipboard) {
+		m.sessionChanged()
+	}
+}
+
+// describeTargets descreve a operação pelo título do vídeo ou pela quantidade de marcados.
+func (m *ReorderModel) describeTargets(action string) string {
+	targets := m.targets()
+	if len(targets) == 1 {
+		return fmt.Sprintf("%s: %s", action, m.edited.Videos[targets[0]].Title)
+	}
+	return fmt.Sprintf("%s: %d vídeos", action, len(targets))
+}
+
+func (m *ReorderModel) undo() tea.Cmd {
+	if playlist, ok := m.history.Undo(); ok {
+		return m.restore(playlist, "Desfeito")
+	}
+	m.statusMessage = "Nada para desfazer."
+	return nil
+}
+
+func (m *ReorderModel) redo() tea.Cmd {
+	if playlist, ok := m.history.Redo(); ok {
+		return m.restore(playlist, "Refeito")
+	}
+	m.statusMessage = "Nada para refazer."
+	return nil
+}
+
+// restore troca a ordem editada por um estado do histórico, com o que estava recortado nele.
+// As marcas deixam de fazer sentido na outra ordem e são descartadas. Fora do modo manual a
+// tabela volta a mostrar a prévia do critério selecionado a partir desse estado.
+func (m *ReorderModel) restore(playlist domain.Playlist, action string) tea.Cmd {
+	entry := m.history.Entries()[m.history.Current()]
+	m.edited = playlist
+	m.clipboard = m.history.Held()
+	m.sessionChanged()
+	m.statusMessage = fmt.Sprintf("%s — estado atual: %s", action, entry.Description)
+	if !m.manual {
+		return m.previewSelected()
+	}
+	clear(m.marks)
+	m.videos.setVideos(m.edited.Videos)
+	return nil
+}
+
+// updateHistory navega na lista do histórico; Enter volta (ou avança) para o estado
+// selecionado sem apagar os demais.
+func (m *ReorderModel) updateHistory(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "up", "k":
+		if m.historyCursor > 0 {
+			m.historyCursor--
+		}
+	case "down", "j":
+		if m.historyCursor < len(m.history.Entries())-1 {
+			m.historyCursor++
+		}
+	case "enter":
+		m.showHistory = false
+		if playlist, ok := m.history.Jump(m.historyCursor); ok {
+			return m, m.restore(playlist, "Histórico")
+		}
+	case "u", "ctrl+z":
+		cmd := m.undo()
+		m.historyCursor = m.history.Current()
+		return m, cmd
+	case "r", "ctrl+y":
+		cmd := m.redo()
+		m.historyCursor = m.history.Current()
+		return m, cmd
+	case "h", "backspace":
+		m.showHistory = false
+	}
+	return m, nil
+}
+
+func (m *ReorderModel) historyView() string {
+	var b strings.Builder
+
+	b.WriteString("Histórico de edições da sessão:\n")
+	entries := m.history.Entries()
+
+	// janela de até 10 estados em volta do cursor
+	start := max(min(m.historyCursor-5, len(entries)-10), 0)
+	end := min(start+10, len(entries))
+	for i := start; i < end; i++ {
+		entry := entries[i]
+		line := fmt.Sprintf("%2d. %s  %s", i, entry.At.Local().Format("15:04:05"), entry.Description)
+		if i == m.history.Current() {
+			line += "  ← atual"
+		}
+		switch {
+		case i == m.historyCursor:
+			b.WriteString(selectedListItemStyle.Render(line))
+		case i > m.history.Current():
+			// estados desfeitos, que ainda podem ser refeitos
+			b.WriteString(welcomePromptStyle.Render("   " + line))
+		default:
+			b.WriteString(listItemStyle.Render(line))
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(welcomePromptStyle.Render("↑/↓ escolhem, Enter volta para o estado selecionado, u/r desfazem/refazem, h ou Backspace fecham."))
+	return b.String()
+}
+
+func (m *ReorderModel) manualHelpView() string {
+	var b strings.Builder
+
+	if m.showHistory {
+		return m.historyView()
+	}
+
+	if m.jumping {
+		b.WriteString(m.jumpInput.View())
+		b.WriteString("\n")
+		b.WriteString(welcomePromptStyle.Render("Enter move, Esc cancela."))
+		return b.String()
+	}
+
+	if len(m.marks) > 0 {
+		b.WriteString(listItemStyle.Render(fmt.Sprintf("%d vídeo(s) marcado(s)", len(m.marks))))
+		b.WriteString("\n")
+	}
+	if m.statusMessage != "" {
+		b.WriteString(statusMessageStyle.Render(m.statusMessage))
+		b.WriteString("\n")
+	}
+	if m.err != nil {
+		b.WriteString(errorMessageStyle.Render(fmt.Sprintf("Erro: %v", m.err)))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(welcomePromptStyle.Render("Modo manual: ↑/↓ navegam, Shift+↑/↓ (ou K/J) movem, t/b levam ao topo/fim, n move para a posição N, " +
+		"Espaço marca vários, x recorta, p/P colam depois/antes do cursor, d remove, D remove duplicatas exatas, u/r desfazem/refazem, h mostra o histórico, / busca, s salva, Backspace volta ao menu mantendo a edição."))
+	return b.String()
+}
@@ -0,0 +1,155 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"TUI_playlist_reorder/internal/core/domain"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// saveProgressMsg traz cada item gravado pelo provider durante a gravação.
+type saveProgressMsg struct {
+	event domain.ProgressEvent
+}
+
+var saveStageLabels = map[string]string{
+	domain.ProgressCreate: "Playlist criada",
+	domain.ProgressRemove: "Removido",
+	domain.ProgressInsert: "Inserido",
+	domain.ProgressMove:   "Movido",
+}
+
+var (
+	progressFilledStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("62"))
+	progressEmptyStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+)
+
+// startSave roda a gravação numa goroutine; o provider informa cada item gravado por
+// saveUpdates e a última mensagem é o savePlaylistMsg com o resultado.
+func (m *ReorderModel) startSave(inPlace bool, title string) tea.Cmd {
+	m.awaitingSave = true
+	m.err = nil
+	m.saveProgress = saveProgress{}
+	if inPlace {
+		m.statusMessage = fmt.Sprintf("Reordenando a playlist \"%s\" no YouTube...", m.playlist.Title)
+	} else {
+		m.statusMessage = fmt.Sprintf("Salvando playlist \"%s\" no YouTube...", title)
+	}
+
+	result := savePlaylistMsg{criteria: m.pendingCriteria, title: title, inPlace: inPlace, manual: m.pendingManual}
+	playlistID := m.playlist.ID
+	edited := m.edited
+	edited.Videos = append([]domain.Video(nil), m.edited.Videos...)
+	removed := m.removedItemIDs()
+
+	name := "reorder"
+	if result.manual {
+		name = "reorder-manual"
+	}
+
+	updates := make(chan tea.Msg)
+	m.saveUpdates = updates
+	ctx := domain.WithProgress(m.parent.startJob(name), func(event domain.ProgressEvent) {
+		updates <- saveProgressMsg{event: event}
+	})
+
+	go func() {
+		var err error
+		if result.manual {
+			err = m.playlistUseCase.SaveOrderedPlaylist(ctx, edited, removed, inPlace, title)
+		} else {
+			err = m.playlistUseCase.ReorderPlaylist(ctx, playlistID, result.criteria, inPlace, title)
+		}
+		result.err = m.parent.finishJob(ctx, err)
+		updates <- result
+	}()
+
+	return waitSaveUpdate(updates)
+}
+
+// stoppedSaveMessage descreve o que ficou no YouTube depois de uma gravação cancelada ou com
+// prazo esgotado; last é o último item gravado.
+func stoppedSaveMessage(inPlace bool, last domain.ProgressEvent) string {
+	switch {
+	case last.Done == 0:
+		return "Gravação cancelada antes de alterar o YouTube; nada foi modificado."
+	case !inPlace:
+		return "Gravação interrompida; a cópia incompleta foi apagada do YouTube."
+	default:
+		return fmt.Sprintf("Gravação interrompida depois de %d de %d itens: a playlist ficou com parte da nova ordem. "+
+			"O snapshot tirado antes guarda a ordem anterior; salvar de novo termina a gravação.", last.Done, last.Total)
+	}
+}
+
+// waitSaveUpdate espera a próxima mensagem da gravação; quem recebe um saveProgressMsg
+// volta a esperar.
+func waitSaveUpdate(updates <-chan tea.Msg) tea.Cmd {
+	return func() tea.Msg {
+		return <-updates
+	}
+}
+
+// saveProgress acompanha a gravação para a barra de progresso.
+type saveProgress struct {
+	first   domain.ProgressEvent // primeiro item gravado; o ritmo é medido a partir dele
+	firstAt time.Time
+	last    domain.ProgressEvent
+	lastAt  time.Time
+}
+
+func (p *saveProgress) update(event domain.ProgressEvent) {
+	now := time.Now()
+	if p.firstAt.IsZero() {
+		// a busca, a ordenação e o snapshot antes do primeiro item não entram no ritmo
+		p.first = event
+		p.firstAt = now
+	}
+	p.last = event
+	p.lastAt = now
+}
+
+// eta estima o tempo restante pelo ritmo médio dos itens gravados depois do primeiro.
+func (p saveProgress) eta() (time.Duration, bool) {
+	done := p.last.Done - p.first.Done
+	if done <= 0 || p.last.Total <= p.last.Done {
+		return 0, false
+	}
+	perItem := p.lastAt.Sub(p.firstAt) / time.Duration(done)
+	return perItem * time.Duration(p.last.Total-p.last.Done), true
+}
+
+func (p saveProgress) View(width int) string {
+	var b strings.Builder
+
+	if p.last.Total == 0 {
+		// antes do primeiro item: busca da playlist, ordenação e snapshot
+		b.WriteString(welcomePromptStyle.Render("Preparando a gravação…"))
+		b.WriteString("\n")
+		return b.String()
+	}
+
+	barWidth := 40
+	if width > 0 {
+		barWidth = min(max(width-30, 10), 60)
+	}
+	ratio := float64(p.last.Done) / float64(p.last.Total)
+	filled := int(ratio * float64(barWidth))
+
+	b.WriteString(progressFilledStyle.Render(strings.Repeat("█", filled)))
+	b.WriteString(progressEmptyStyle.Render(strings.Repeat("░", barWidth-filled)))
+	b.WriteString(fmt.Sprintf(" %d/%d (%d%%)", p.last.Done, p.last.Total, int(ratio*100)))
+	if eta, ok := p.eta(); ok {
+		b.WriteString(fmt.Sprintf(" — faltam ~%s", eta.Round(time.Second)))
+	}
+	b.WriteString("\n")
+
+	if p.last.Title != "" {
+		b.WriteString(listItemStyle.Render(fmt.Sprintf("%s: %s", saveStageLabels[p.last.Stage], p.last.Title)))
+		b.WriteString("\n")
+	}
+	return b.String()
+}
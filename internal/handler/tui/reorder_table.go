@@ -0,0 +1,271 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"TUI_playlist_reorder/internal/core/domain"
+
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// colunas da tabela de vídeos; as teclas 1–6 ordenam a exibição pela coluna correspondente
+const (
+	videoColPosition = iota
+	videoColTitle
+	videoColArtist
+	videoColDuration
+	videoColLanguage
+	videoColPublished
+)
+
+var videoColumnTitles = []string{"#", "Título", "Artista", "Duração", "Idioma", "Publicado"}
+
+// larguras fixas de #, duração, idioma e data; título e artista dividem o resto da tela
+var videoFixedWidths = map[int]int{
+	videoColPosition:  4,
+	videoColDuration:  8,
+	videoColLanguage:  6,
+	videoColPublished: 10,
+}
+
+// videoTable mostra os vídeos na ordem recebida (a da playlist ou a da prévia de um
+// critério). Ordenar por coluna e filtrar com a busca mudam só a exibição; a coluna #
+// continua indicando a posição do vídeo na ordem recebida.
+type videoTable struct {
+	table      table.Model
+	videos     []domain.Video
+	sortColumn int // -1 = ordem recebida
+	sortDesc   bool
+	filter     string // busca fuzzy por título e artista
+	width      int
+	maxHeight  int
+	marks      map[int]bool // posições (0-based) marcadas no modo manual
+	positions  []int        // posição (0-based) do vídeo de cada linha exibida
+}
+
+func newVideoTable() videoTable {
+	t := table.New(table.WithFocused(false))
+
+	styles := table.DefaultStyles()
+	styles.Header = styles.Header.
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderForeground(lipgloss.Color("240")).
+		BorderBottom(true).
+		Bold(true)
+	styles.Selected = styles.Selected.
+		Foreground(lipgloss.Color("230")).
+		Background(lipgloss.Color("62")).
+		Bold(false)
+	t.SetStyles(styles)
+
+	vt := videoTable{table: t, sortColumn: -1}
+	vt.resize(0, 0)
+	return vt
+}
+
+// resize ajusta as colunas à largura e a altura às linhas disponíveis; zero usa um padrão
+// (antes do primeiro tea.WindowSizeMsg).
+func (t *videoTable) resize(width, height int) {
+	if width <= 0 {
+		width = 100
+	}
+	if height <= 0 {
+		height = 10
+	}
+	t.width = width
+	t.maxHeight = height
+
+	// cada célula tem 1 espaço de padding de cada lado
+	fixed := 0
+	for _, w := range videoFixedWidths {
+		fixed += w + 2
+	}
+	rest := max(width-fixed-4, 20)
+	titleWidth := rest * 3 / 5
+
+	widths := []int{
+		videoFixedWidths[videoColPosition],
+		titleWidth,
+		rest - titleWidth,
+		videoFixedWidths[videoColDuration],
+		videoFixedWidths[videoColLanguage],
+		videoFixedWidths[videoColPublished],
+	}
+
+	columns := make([]table.Column, len(videoColumnTitles))
+	for i, title := range videoColumnTitles {
+		if i == t.sortColumn {
+			if t.sortDesc {
+				title += " ▼"
+			} else {
+				title += " ▲"
+			}
+		}
+		columns[i] = table.Column{Title: title, Width: widths[i]}
+	}
+
+	// as linhas precisam ser trocadas junto com as colunas
+	t.table.SetRows(nil)
+	t.table.SetColumns(columns)
+	t.table.SetWidth(width)
+	t.refresh()
+}
+
+func (t *videoTable) setVideos(videos []domain.Video) {
+	t.videos = videos
+	t.refresh()
+}
+
+// setFilter filtra as linhas pela busca, mantendo o cursor no mesmo vídeo se ele continuar
+// visível.
+func (t *videoTable) setFilter(filter string) {
+	selected, ok := t.selectedPosition()
+	t.filter = filter
+	t.refresh()
+	if ok {
+		t.selectPosition(selected)
+	}
+}
+
+// selectedPosition devolve a posição na ordem recebida do vídeo sob o cursor.
+func (t *videoTable) selectedPosition() (int, bool) {
+	cursor := t.table.Cursor()
+	if cursor < 0 || cursor >= len(t.positions) {
+		return 0, false
+	}
+	return t.positions[cursor], true
+}
+
+// selectPosition põe o cursor na linha do vídeo da posição informada, se ela estiver visível.
+func (t *videoTable) selectPosition(position int) bool {
+	for row, pos := range t.positions {
+		if pos == position {
+			t.table.SetCursor(row)
+			return true
+		}
+	}
+	return false
+}
+
+// sortBy ordena a exibição pela coluna; repetir a coluna inverte a direção e uma coluna
+// negativa volta para a ordem recebida.
+func (t *videoTable) sortBy(column int) {
+	switch {
+	case column < 0:
+		t.sortColumn = -1
+		t.sortDesc = false
+	case column == t.sortColumn:
+		t.sortDesc = !t.sortDesc
+	default:
+		t.sortColumn = column
+		t.sortDesc = false
+	}
+	t.resize(t.width, t.maxHeight)
+}
+
+func (t *videoTable) refresh() {
+	type positioned struct {
+		position int
+		video    domain.Video
+		matches  [][]int // posições destacadas no título e no artista
+	}
+
+	entries := make([]positioned, 0, len(t.videos))
+	for i, video := range t.videos {
+		entry := positioned{position: i + 1, video: video}
+		if t.filter != "" {
+			_, matches, ok := fuzzyMatchFields(t.filter, video.Title, video.Artist)
+			if !ok {
+				continue
+			}
+			entry.matches = matches
+		}
+		entries = append(entries, entry)
+	}
+
+	if t.sortColumn >= 0 {
+		less := videoColumnLess(t.sortColumn)
+		sort.SliceStable(entries, func(i, j int) bool {
+			a, b := entries[i], entries[j]
+			if t.sortDesc {
+				a, b = b, a
+			}
+			if t.sortColumn == videoColPosition {
+				return a.position < b.position
+			}
+			return less(a.video, b.video)
+		})
+	}
+
+	columns := t.table.Columns()
+	rows := make([]table.Row, len(entries))
+	t.positions = make([]int, len(entries))
+	for i, entry := range entries {
+		t.positions[i] = entry.position - 1
+		title, artist := entry.video.Title, entry.video.Artist
+		if entry.matches != nil {
+			title = highlightCell(title, entry.matches[0], columns[videoColTitle].Width)
+			artist = highlightCell(artist, entry.matches[1], columns[videoColArtist].Width)
+		}
+
+		video := entry.video
+		published := ""
+		if !video.PublishedAt.IsZero() {
+			published = video.PublishedAt.Local().Format(time.DateOnly)
+		}
+		mark := ""
+		if t.marks[entry.position-1] {
+			mark = "•"
+		}
+		rows[i] = table.Row{
+			fmt.Sprintf("%s%d", mark, entry.position),
+			title,
+			artist,
+			formatVideoDuration(video.Duration),
+			video.Language,
+			published,
+		}
+	}
+
+	// playlists curtas não precisam ocupar a altura toda (2 linhas do cabeçalho)
+	t.table.SetHeight(min(t.maxHeight, max(len(rows), 1)+2))
+	t.table.SetRows(rows)
+	// a tabela vazia deixa o cursor em -1
+	switch cursor := t.table.Cursor(); {
+	case cursor < 0:
+		t.table.SetCursor(0)
+	case cursor >= len(rows):
+		t.table.SetCursor(max(len(rows)-1, 0))
+	}
+}
+
+func videoColumnLess(column int) func(a, b domain.Video) bool {
+	switch column {
+	case videoColTitle:
+		return func(a, b domain.Video) bool { return strings.ToLower(a.Title) < strings.ToLower(b.Title) }
+	case videoColArtist:
+		return func(a, b domain.Video) bool { return strings.ToLower(a.Artist) < strings.ToLower(b.Artist) }
+	case videoColDuration:
+		return func(a, b domain.Video) bool { return a.Duration < b.Duration }
+	case videoColLanguage:
+		return func(a, b domain.Video) bool { return a.Language < b.Language }
+	default:
+		return func(a, b domain.Video) bool { return a.PublishedAt.Before(b.PublishedAt) }
+	}
+}
+
+// formatVideoDuration usa m:ss ou h:mm:ss, como o YouTube.
+func formatVideoDuration(d time.Duration) string {
+	d = d.Round(time.Second)
+	h := int(d.Hours())
+	minutes := int(d.Minutes()) % 60
+	seconds := int(d.Seconds()) % 60
+	if h > 0 {
+		return fmt.Sprintf("%d:%02d:%02d", h, minutes, seconds)
+	}
+	return fmt.Sprintf("%d:%02d", minutes, seconds)
+}
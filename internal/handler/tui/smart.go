@@ -0,0 +1,366 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"TUI_playlist_reorder/internal/core/domain"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type smartPlaylistsLoadedMsg struct{ definitions []domain.SmartPlaylist }
+type smartPlaylistBuiltMsg struct{ playlist domain.Playlist }
+type smartPlaylistPreviewMsg struct{ playlist domain.Playlist }
+type smartPlaylistSavedMsg struct{ name string }
+type smartPlaylistDeletedMsg struct{ name string }
+type smartPlaylistErrorMsg struct{ err error }
+
+const (
+	smartFieldName = iota
+	smartFieldTitle
+	smartFieldSources
+	smartFieldFilter
+	smartFieldFilterScript
+	smartFieldSort
+)
+
+var smartFieldLabels = []string{"Nome", "Título", "Origens (IDs ou URLs, separados por vírgula)", "Filtro", "Script de filtro (opcional)", "Ordenação"}
+
+type SmartPlaylistsModel struct {
+	parent      *AppModel
+	definitions []domain.SmartPlaylist
+	cursor      int
+
+	editing    bool
+	inputs     []textinput.Model
+	focusIndex int
+
+	preview *domain.Playlist
+
+	loading       bool
+	statusMessage string
+	err           error
+}
+
+func NewSmartPlaylistsModel(parent *AppModel) *SmartPlaylistsModel {
+	return &SmartPlaylistsModel{
+		parent:  parent,
+		loading: true,
+	}
+}
+
+func (m *SmartPlaylistsModel) Init() tea.Cmd {
+	m.loading = true
+	m.err = nil
+	m.editing = false
+	m.preview = nil
+
+	return func() tea.Msg {
+		definitions, err := m.parent.playlistUseCase.ListSmartPlaylists()
+		if err != nil {
+			return smartPlaylistErrorMsg{err: err}
+		}
+		return smartPlaylistsLoadedMsg{definitions: definitions}
+	}
+}
+
+func (m *SmartPlaylistsModel) startEditing() tea.Cmd {
+	m.editing = true
+	m.err = nil
+	m.inputs = make([]textinput.Model, len(smartFieldLabels))
+	for i := range m.inputs {
+		input := textinput.New()
+		input.Prompt = "> "
+		input.CharLimit = 512
+		input.Width = 60
+		m.inputs[i] = input
+	}
+	m.inputs[smartFieldFilter].Placeholder = `duration < 10m and language in (pt, en) and not artist ~ "Topic"`
+	m.inputs[smartFieldSort].Placeholder = "published desc, title"
+
+	// sugere os scripts de filtro disponíveis na biblioteca local
+	if scripts, err := m.parent.playlistUseCase.ListScripts(); err == nil {
+		var names []string
+		for _, script := range scripts {
+			if script.CanFilter {
+				names = append(names, script.Name)
+			}
+		}
+		if len(names) > 0 {
+			m.inputs[smartFieldFilterScript].Placeholder = "disponíveis: " + strings.Join(names, ", ")
+		}
+	}
+	m.focusIndex = 0
+	return m.inputs[0].Focus()
+}
+
+func (m *SmartPlaylistsModel) definitionFromInputs() domain.SmartPlaylist {
+	var sources []string
+	for _, source := range strings.Split(m.inputs[smartFieldSources].Value(), ",") {
+		if source = strings.TrimSpace(source); source != "" {
+			sources = append(sources, source)
+		}
+	}
+
+	return domain.SmartPlaylist{
+		Name:         strings.TrimSpace(m.inputs[smartFieldName].Value()),
+		Title:        strings.TrimSpace(m.inputs[smartFieldTitle].Value()),
+		Sources:      sources,
+		Filter:       strings.TrimSpace(m.inputs[smartFieldFilter].Value()),
+		FilterScript: strings.TrimSpace(m.inputs[smartFieldFilterScript].Value()),
+		Sort:         strings.TrimSpace(m.inputs[smartFieldSort].Value()),
+	}
+}
+
+// typing indica se o formulário de definição está aberto; Esc o fecha sem salvar.
+func (m *SmartPlaylistsModel) typing() bool {
+	return m.editing
+}
+
+func (m *SmartPlaylistsModel) updateEditing(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyTab, tea.KeyShiftTab, tea.KeyUp, tea.KeyDown:
+		m.inputs[m.focusIndex].Blur()
+		if msg.Type == tea.KeyShiftTab || msg.Type == tea.KeyUp {
+			m.focusIndex = (m.focusIndex + len(m.inputs) - 1) % len(m.inputs)
+		} else {
+			m.focusIndex = (m.focusIndex + 1) % len(m.inputs)
+		}
+		return m, m.inputs[m.focusIndex].Focus()
+
+	case tea.KeyEnter:
+		if m.focusIndex < len(m.inputs)-1 {
+			m.inputs[m.focusIndex].Blur()
+			m.focusIndex++
+			return m, m.inputs[m.focusIndex].Focus()
+		}
+
+		definition := m.definitionFromInputs()
+		return m, func() tea.Msg {
+			if err := m.parent.playlistUseCase.SaveSmartPlaylist(definition); err != nil {
+				return smartPlaylistErrorMsg{err: err}
+			}
+			return smartPlaylistSavedMsg{name: definition.Name}
+		}
+
+	case tea.KeyCtrlX, tea.KeyEsc:
+		m.editing = false
+		m.err = nil
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.inputs[m.focusIndex], cmd = m.inputs[m.focusIndex].Update(msg)
+	return m, cmd
+}
+
+func (m *SmartPlaylistsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case smartPlaylistsLoadedMsg:
+		m.loading = false
+		m.definitions = msg.definitions
+		if m.cursor >= len(m.definitions) {
+			m.cursor = 0
+		}
+		return m, nil
+
+	case smartPlaylistSavedMsg:
+		cmd := m.Init()
+		m.statusMessage = fmt.Sprintf("Smart playlist '%s' salva.", msg.name)
+		return m, cmd
+
+	case smartPlaylistDeletedMsg:
+		cmd := m.Init()
+		m.statusMessage = fmt.Sprintf("Smart playlist '%s' removida.", msg.name)
+		return m, cmd
+
+	case smartPlaylistBuiltMsg:
+		cmd := m.Init()
+		m.statusMessage = fmt.Sprintf("Playlist \"%s\" gerada com %d vídeos.", msg.playlist.Title, len(msg.playlist.Videos))
+		return m, cmd
+
+	case smartPlaylistPreviewMsg:
+		m.loading = false
+		m.preview = &msg.playlist
+		return m, nil
+
+	case smartPlaylistErrorMsg:
+		m.loading = false
+		m.err = msg.err
+		m.parent.logger.Error("SmartPlaylistsModel: erro", msg.err)
+		return m, nil
+
+	case tea.KeyMsg:
+		if m.loading {
+			return m, nil
+		}
+
+		if m.editing {
+			return m.updateEditing(msg)
+		}
+
+		if m.preview != nil {
+			if msg.Type == tea.KeyBackspace {
+				m.preview = nil
+			}
+			return m, nil
+		}
+
+		switch msg.Type {
+		case tea.KeyUp:
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case tea.KeyDown:
+			if m.cursor < len(m.definitions)-1 {
+				m.cursor++
+			}
+		case tea.KeyBackspace:
+			return m, m.parent.send(showPlaylistsMsg{})
+		case tea.KeyEnter:
+			if len(m.definitions) == 0 {
+				return m, nil
+			}
+			name := m.definitions[m.cursor].Name
+			m.loading = true
+			m.err = nil
+			m.statusMessage = fmt.Sprintf("Gerando smart playlist '%s' no YouTube. Aguarde... (Esc cancela)", name)
+			return m, func() tea.Msg {
+				ctx := m.parent.startJob("smart-build")
+				playlist, err := m.parent.playlistUseCase.BuildSmartPlaylist(ctx, name)
+				err = m.parent.finishJob(ctx, err)
+				if err != nil {
+					return smartPlaylistErrorMsg{err: err}
+				}
+				return smartPlaylistBuiltMsg{playlist: playlist}
+			}
+		case tea.KeyRunes:
+			switch string(msg.Runes) {
+			case "n":
+				return m, m.startEditing()
+			case "p":
+				if len(m.definitions) == 0 {
+					return m, nil
+				}
+				definition := m.definitions[m.cursor]
+				m.loading = true
+				m.err = nil
+				m.statusMessage = ""
+				return m, func() tea.Msg {
+					ctx := m.parent.startJob("smart-preview")
+					playlist, err := m.parent.playlistUseCase.PreviewSmartPlaylist(ctx, definition)
+					err = m.parent.finishJob(ctx, err)
+					if err != nil {
+						return smartPlaylistErrorMsg{err: err}
+					}
+					return smartPlaylistPreviewMsg{playlist: playlist}
+				}
+			case "x":
+				if len(m.definitions) == 0 {
+					return m, nil
+				}
+				name := m.definitions[m.cursor].Name
+				return m, func() tea.Msg {
+					if err := m.parent.playlistUseCase.DeleteSmartPlaylist(name); err != nil {
+						return smartPlaylistErrorMsg{err: err}
+					}
+					return smartPlaylistDeletedMsg{name: name}
+				}
+			}
+		}
+	}
+
+	return m, nil
+}
+
+func (m *SmartPlaylistsModel) View() string {
+	var b strings.Builder
+
+	b.WriteString(listHeaderStyle.Render("Smart Playlists"))
+	b.WriteString("\n\n")
+
+	if m.loading {
+		if m.statusMessage != "" {
+			b.WriteString("⏳ ")
+			b.WriteString(statusMessageStyle.Render(m.statusMessage))
+		} else {
+			b.WriteString("Carregando…")
+		}
+		b.WriteString("\n")
+		return docStyle.Render(b.String())
+	}
+
+	if m.editing {
+		b.WriteString("Nova smart playlist:\n\n")
+		for i, input := range m.inputs {
+			b.WriteString(welcomePromptStyle.Render(smartFieldLabels[i]))
+			b.WriteString("\n")
+			b.WriteString(input.View())
+			b.WriteString("\n\n")
+		}
+		if m.err != nil {
+			b.WriteString(errorMessageStyle.Render(fmt.Sprintf("Erro: %v", m.err)))
+			b.WriteString("\n\n")
+		}
+		b.WriteString(welcomePromptStyle.Render("Tab/↑/↓ trocam de campo, Enter no último campo salva, Esc ou Ctrl+X cancela."))
+		return docStyle.Render(b.String())
+	}
+
+	if m.preview != nil {
+		b.WriteString(fmt.Sprintf("Prévia de \"%s\" (%d vídeos):\n\n", m.preview.Title, len(m.preview.Videos)))
+		limit := len(m.preview.Videos)
+		if maxLines := m.parent.height - 10; maxLines > 0 && limit > maxLines {
+			limit = maxLines
+		}
+		for i, video := range m.preview.Videos[:limit] {
+			b.WriteString(listItemStyle.Render(fmt.Sprintf("%d. %s — %s (%s)", i+1, video.Title, video.Artist, video.Duration)))
+			b.WriteString("\n")
+		}
+		if limit < len(m.preview.Videos) {
+			b.WriteString(listItemStyle.Render(fmt.Sprintf("… e mais %d vídeos", len(m.preview.Videos)-limit)))
+			b.WriteString("\n")
+		}
+		b.WriteString("\n")
+		b.WriteString(welcomePromptStyle.Render("Backspace para voltar."))
+		return docStyle.Render(b.String())
+	}
+
+	if len(m.definitions) == 0 {
+		b.WriteString(welcomePromptStyle.Render("Nenhuma smart playlist definida. Pressione n para criar uma."))
+		b.WriteString("\n")
+	}
+
+	for i, definition := range m.definitions {
+		lastBuilt := "nunca gerada"
+		if !definition.LastBuiltAt.IsZero() {
+			lastBuilt = "gerada em " + definition.LastBuiltAt.Local().Format(time.DateTime)
+		}
+		line := fmt.Sprintf("%s (%s)", definition.Name, lastBuilt)
+		if i == m.cursor {
+			b.WriteString(selectedListItemStyle.Render(line))
+			b.WriteString("\n")
+			b.WriteString(listItemStyle.Render(welcomePromptStyle.Render(fmt.Sprintf("  filtro: %s | ordenação: %s | %d origens", definition.Filter, definition.Sort, len(definition.Sources)))))
+		} else {
+			b.WriteString(listItemStyle.Render(line))
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+
+	if m.statusMessage != "" {
+		b.WriteString(statusMessageStyle.Render(m.statusMessage))
+		b.WriteString("\n")
+	}
+	if m.err != nil {
+		b.WriteString(errorMessageStyle.Render(fmt.Sprintf("Erro: %v", m.err)))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(welcomePromptStyle.Render("Enter gera no YouTube, p mostra prévia, n cria nova, x remove, Backspace volta."))
+
+	return docStyle.Render(b.String())
+}
@@ -6,7 +6,6 @@ import (
 
 	"TUI_playlist_reorder/internal/core/domain"
 	"TUI_playlist_reorder/internal/core/usecases"
-	"context"
 
 	tea "github.com/charmbracelet/bubbletea"
 )
@@ -65,7 +64,9 @@ func (m *URLModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.err = nil
 			// Dispara o fetch da playlist via URL
 			return m, func() tea.Msg {
-				playlist, err := m.parent.playlistUseCase.GetPlaylistByURL(context.Background(), url)
+				ctx := m.parent.startJob("fetch-url")
+				playlist, err := m.parent.playlistUseCase.GetPlaylistByURL(ctx, url)
+				err = m.parent.finishJob(ctx, err)
 				if err != nil {
 					return urlEnterErrorMsg{err: err}
 				}
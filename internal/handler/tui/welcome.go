@@ -1,6 +1,7 @@
 package tui
 
 import (
+	"fmt"
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -8,6 +9,7 @@ import (
 
 type WelcomeModel struct {
 	parent *AppModel
+	err    error // falha ao revogar o token da sessão encerrada, mostrada até o próximo login
 }
 
 func NewWelcomeModel(parent *AppModel) *WelcomeModel {
@@ -25,6 +27,7 @@ func (m *WelcomeModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		switch msg.Type {
 		case tea.KeyEnter:
 			// Navegar para a tela de login
+			m.err = nil
 			return m, m.parent.send(showLoginMsg{})
 		}
 	}
@@ -40,7 +43,15 @@ func (m *WelcomeModel) View() string {
 	b.WriteString(title)
 	b.WriteString("\n\n")
 	b.WriteString(prompt)
+	b.WriteString("\n")
+	b.WriteString(welcomePromptStyle.Render(fmt.Sprintf("Perfil: %s", m.parent.profile)))
 	b.WriteString("\n\n")
+	if m.err != nil {
+		b.WriteString(errorMessageStyle.Render(fmt.Sprintf("Sessão encerrada e token local apagado, mas a revogação no Google falhou: %v", m.err)))
+		b.WriteString("\n")
+		b.WriteString(welcomePromptStyle.Render("O acesso pode ser removido manualmente em https://myaccount.google.com/permissions."))
+		b.WriteString("\n\n")
+	}
 	b.WriteString(welcomePromptStyle.Render("(Ctrl+C ou Esc para sair)"))
 
 	return docStyle.Render(b.String())
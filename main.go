@@ -3,57 +3,210 @@ package main
 
 import (
 	"TUI_playlist_reorder/infrastructure/auth"
+	"TUI_playlist_reorder/infrastructure/config"
+	"TUI_playlist_reorder/infrastructure/journal"
 	"TUI_playlist_reorder/infrastructure/logger"
 	"TUI_playlist_reorder/infrastructure/provider"
+	"TUI_playlist_reorder/infrastructure/script"
+	"TUI_playlist_reorder/infrastructure/smartplaylist"
+	"TUI_playlist_reorder/infrastructure/snapshot"
+	"TUI_playlist_reorder/infrastructure/sorter"
+	"TUI_playlist_reorder/internal/handler/cli"
 	"TUI_playlist_reorder/internal/handler/server"
 	"TUI_playlist_reorder/internal/handler/tui"
 
 	"TUI_playlist_reorder/infrastructure/token_manager"
 	"TUI_playlist_reorder/internal/core/usecases"
+	"context"
+	"errors"
+	"flag"
 	"fmt"
 	"os"
+	"os/signal"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"google.golang.org/api/youtube/v3" // For scopes
 )
 
-const (
-	clientSecretFilePath = "./infrastructure/auth/client_secret.json"
-	tokenFilePath        = "./infrastructure/token_manager/token.json"
-	callbackURL          = "http://localhost:8080"
-)
-
 func main() {
+	// Arquivo de configuração → variáveis de ambiente → flags
+	cfg, args, err := config.Load(os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		cli.Usage(os.Stdout)
+		return
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load configuration: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Move token, credenciais e dados das localizações antigas (dentro do repositório) para o XDG
+	migrations, migrateErr := config.MigrateLegacy(cfg)
+
 	// Initialize Logger
-	appLogger, err := logger.NewFileLogger("logs", "reorder_playlist_tui")
+	// No modo CLI avisos e erros também vão para o stderr
+	logOptions := []logger.Option{logger.WithRotation(cfg.LogRotation()), logger.WithFormat(cfg.LogFormat)}
+	if len(args) > 0 {
+		logOptions = append(logOptions, logger.WithMirror(os.Stderr))
+	}
+	appLogger, err := logger.NewFileLogger(cfg.LogDir, "reorder_playlist_tui", cfg.LogLevel, logOptions...)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Failed to initialize logger: %v\n", err)
 		os.Exit(1)
 	}
 	defer appLogger.Close()
 	appLogger.Info("Application starting...")
+	appLogger.Info("Configuration loaded", "config_file", cfg.Path)
+	for _, migration := range migrations {
+		appLogger.Info("Migrated legacy file", "from", migration.From, "to", migration.To)
+	}
+	if migrateErr != nil {
+		appLogger.Error("Failed to migrate legacy files", migrateErr)
+		if len(args) == 0 { // no modo CLI o logger já espelha o erro no stderr
+			fmt.Fprintf(os.Stderr, "Aviso: %v\n", migrateErr)
+		}
+	}
 
 	// Initialize Services
-	tokenService := token_manager.NewTokenService(tokenFilePath)
-
-	authService, err := auth.NewAuthenticationService(
-		[]string{youtube.YoutubeScope}, // Added youtube.YoutubeScope
-		clientSecretFilePath,
-		callbackURL,
-		tokenService,
-	)
+	// Cada perfil (conta Google ou canal de marca) tem seu próprio token
+	var passphrase token_manager.PassphraseSource
+	profileStore := token_manager.NewProfileStore(cfg.TokenFile, func(path string) token_manager.TokenService {
+		if cfg.TokenBackend == "encrypted" {
+			return token_manager.NewEncryptedTokenService(path, passphrase)
+		}
+		return token_manager.NewTokenService(path)
+	})
+	if cfg.TokenBackend == "encrypted" {
+		// sem token salvo ainda, a senha é nova e pedimos confirmação
+		passphrase = token_manager.NewPassphraseSource(os.Stderr, !profileStore.Exists(cfg.Profile))
+	}
+	accounts := provider.NewAccountRegistry(profileStore, appLogger, cfg.Privacy, cfg.QuotaBudget, cfg.APITimeout(), cfg.CacheDir)
+	snapshotStore := snapshot.NewSnapshotStore(cfg.SnapshotsDir)
+	smartPlaylistStore := smartplaylist.NewSmartPlaylistStore(cfg.SmartPlaylistsFile)
+	jobJournal := journal.NewJobJournal(cfg.JobJournalFile)
+
+	pluginSorters, err := sorter.DiscoverPlugins(cfg.SorterPluginsDir, appLogger)
+	if err != nil {
+		appLogger.Warning("Failed to load sorter plugins", "err", err)
+	}
+
+	scriptLibrary := script.NewStarlarkLibrary(cfg.ScriptsDir, appLogger)
+	scriptSorters, err := scriptLibrary.Sorters()
+	if err != nil {
+		appLogger.Warning("Failed to load sort scripts", "err", err)
+	}
+	extraSorters := append(pluginSorters, scriptSorters...)
+
+	newPlaylistUseCase := func(profile string) (usecases.PlaylistUseCase, error) {
+		youtubeProvider, err := accounts.YoutubeFor(profile)
+		if err != nil {
+			return nil, err
+		}
+
+		return usecases.NewPlaylistUseCase(
+			youtubeProvider,
+			snapshotStore,
+			smartPlaylistStore,
+			scriptLibrary,
+			extraSorters,
+			accounts,
+			profile,
+			appLogger,
+		), nil
+	}
+
+	newAuthService := func(profile string) (auth.AuthenticationService, error) {
+		tokenService, err := profileStore.TokenService(profile)
+		if err != nil {
+			return nil, err
+		}
+
+		authService, err := auth.NewAuthenticationService(
+			[]string{youtube.YoutubeScope}, // Added youtube.YoutubeScope
+			cfg.ClientSecretFile,
+			cfg.DeviceClientSecretFile(),
+			tokenService,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize auth service: %w", err)
+		}
+		return authService, nil
+	}
+
+	// Com argumentos, roda como CLI em vez de abrir a TUI
+	if len(args) > 0 {
+		playlistUseCase, err := newPlaylistUseCase(cfg.Profile)
+		if err != nil {
+			appLogger.Error("Failed to select profile", err)
+			appLogger.Close()
+			os.Exit(1)
+		}
+
+		// Ctrl+C cancela a chamada em andamento em vez de matar o processo no meio de uma gravação
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		commandLine := cli.NewCLI(playlistUseCase, newAuthService, cfg, appLogger, jobJournal, os.Stdout)
+		err = commandLine.Run(ctx, args)
+		stop()
+		if err != nil {
+			appLogger.Error("Error running CLI command", err)
+			appLogger.Close()
+			os.Exit(1)
+		}
+		appLogger.Info("Application finished.")
+		return
+	}
+
+	newSession := func(profile string) (tui.Session, error) {
+		tokenService, err := profileStore.TokenService(profile)
+		if err != nil {
+			return tui.Session{}, err
+		}
+
+		playlistUseCase, err := newPlaylistUseCase(profile)
+		if err != nil {
+			return tui.Session{}, err
+		}
+
+		authService, err := newAuthService(profile)
+		if err != nil {
+			return tui.Session{}, err
+		}
+
+		return tui.Session{
+			Profile:         profile,
+			AuthService:     authService,
+			TokenService:    tokenService,
+			PlaylistUseCase: playlistUseCase,
+		}, nil
+	}
+
+	// A TUI ocupa o terminal, então a senha do token precisa ser pedida antes
+	if passphrase != nil {
+		if _, err := passphrase(); err != nil {
+			appLogger.Error("Failed to read token passphrase", err)
+			fmt.Fprintf(os.Stderr, "Erro: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	session, err := newSession(cfg.Profile)
 	if err != nil {
-		appLogger.Error("Failed to initialize auth service", err)
-		fmt.Fprintf(os.Stderr, "Failed to initialize auth service: %v\n", err)
+		appLogger.Error("Failed to initialize session", err)
+		fmt.Fprintf(os.Stderr, "Failed to initialize session: %v\n", err)
 		os.Exit(1)
 	}
 
 	callbackHandler := server.NewCallbackHandler(appLogger)
-	youtubeProvider := provider.NewYoutubeProvider(tokenService, appLogger)
-	playlistUseCase := usecases.NewPlaylistUseCase(youtubeProvider, appLogger)
 
 	// Create the initial TUI model
-	initialModel := tui.NewAppModel(authService, callbackHandler, playlistUseCase, tokenService, appLogger)
+	settings := tui.Settings{
+		CallbackAddress: cfg.CallbackAddress,
+		LoginMethod:     cfg.EffectiveLoginMethod(),
+		DefaultSort:     cfg.DefaultSort,
+		TitleTemplate:   cfg.TitleTemplate,
+		JobTimeout:      cfg.JobTimeout(),
+	}
+	initialModel := tui.NewAppModel(session, newSession, callbackHandler, appLogger, jobJournal, settings)
 
 	// Start Bubble Tea program
 	p := tea.NewProgram(initialModel, tea.WithAltScreen())